@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func (api *API) waitUntilCustomDomainConfigured(ctx context.Context, instanceID int,
-	configured bool, sleep time.Duration) (map[string]any, error) {
+func (api *API) waitUntilCustomDomainConfigured(ctx context.Context, instanceID int64,
+	configured bool, sleep time.Duration) (*model.CustomDomainResponse, error) {
 
 	for {
 		select {
@@ -24,26 +25,27 @@ func (api *API) waitUntilCustomDomainConfigured(ctx context.Context, instanceID
 			return nil, err
 		}
 
-		if response["configured"] == configured {
+		if response != nil && response.Configured == configured {
 			return response, nil
 		}
 
-		tflog.Debug(ctx, fmt.Sprintf("configure custom domain still waiting, response=%v ", response))
+		tflog.Debug(ctx, fmt.Sprintf("configure custom domain still waiting, response=%+v ", response))
 		time.Sleep(sleep)
 	}
 }
 
-func (api *API) CreateCustomDomain(ctx context.Context, instanceID int, hostname string,
-	sleep time.Duration) (map[string]any, error) {
+// CreateCustomDomain: adds the custom domain, waits until configured if wait is set otherwise
+// returns the current custom domain.
+func (api *API) CreateCustomDomain(ctx context.Context, instanceID int64, hostname string,
+	wait bool, sleep time.Duration) (*model.CustomDomainResponse, error) {
 
 	var (
 		failed map[string]any
-		params = make(map[string]string)
+		params = model.CustomDomainRequest{Hostname: hostname}
 		path   = fmt.Sprintf("/api/instances/%d/custom-domain", instanceID)
 	)
 
 	tflog.Debug(ctx, fmt.Sprintf("method=POST path=%s hostname=%s ", path, hostname))
-	params["hostname"] = hostname
 	err := api.callWithRetry(ctx, api.sling.New().Post(path).BodyJSON(params), retryRequest{
 		functionName: "CreateCustomDomain",
 		resourceName: "CustomDomain",
@@ -56,16 +58,21 @@ func (api *API) CreateCustomDomain(ctx context.Context, instanceID int, hostname
 		return nil, err
 	}
 
+	if !wait {
+		return api.ReadCustomDomain(ctx, instanceID, sleep)
+	}
 	return api.waitUntilCustomDomainConfigured(ctx, instanceID, true, sleep)
 }
 
-func (api *API) ReadCustomDomain(ctx context.Context, instanceID int, sleep time.Duration) (
-	map[string]any, error) {
+// ReadCustomDomain: reads the custom domain, returns nil if the instance is not found.
+func (api *API) ReadCustomDomain(ctx context.Context, instanceID int64, sleep time.Duration) (
+	*model.CustomDomainResponse, error) {
 
 	var (
-		data   map[string]any
-		failed map[string]any
-		path   = fmt.Sprintf("/api/instances/%d/custom-domain", instanceID)
+		data       model.CustomDomainResponse
+		failed     map[string]any
+		statusCode int
+		path       = fmt.Sprintf("/api/instances/%d/custom-domain", instanceID)
 	)
 
 	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s ", path))
@@ -76,21 +83,23 @@ func (api *API) ReadCustomDomain(ctx context.Context, instanceID int, sleep time
 		sleep:        sleep,
 		data:         &data,
 		failed:       &failed,
+		statusCode:   &statusCode,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	// Handle resource drift
-	if len(data) == 0 {
+	if statusCode == 404 || statusCode == 410 {
 		return nil, nil
 	}
 
-	return data, nil
+	return &data, nil
 }
 
-func (api *API) UpdateCustomDomain(ctx context.Context, instanceID int, hostname string,
-	sleep time.Duration) (map[string]any, error) {
+// UpdateCustomDomain: replaces the custom domain, waits until configured if wait is set.
+func (api *API) UpdateCustomDomain(ctx context.Context, instanceID int64, hostname string,
+	wait bool, sleep time.Duration) (*model.CustomDomainResponse, error) {
 
 	tflog.Debug(ctx, fmt.Sprintf("update custom domain, instanceID=%d hostname=%s ",
 		instanceID, hostname))
@@ -106,15 +115,15 @@ func (api *API) UpdateCustomDomain(ctx context.Context, instanceID int, hostname
 	}
 
 	// create and wait
-	_, err = api.CreateCustomDomain(ctx, instanceID, hostname, sleep)
-	if err != nil {
-		return nil, err
+	data, err := api.CreateCustomDomain(ctx, instanceID, hostname, wait, sleep)
+	if err != nil || !wait {
+		return data, err
 	}
 	return api.waitUntilCustomDomainConfigured(ctx, instanceID, true, sleep)
 }
 
-func (api *API) DeleteCustomDomain(ctx context.Context, instanceID int, sleep time.Duration) (
-	map[string]any, error) {
+func (api *API) DeleteCustomDomain(ctx context.Context, instanceID int64, sleep time.Duration) (
+	*model.CustomDomainResponse, error) {
 
 	var (
 		failed map[string]any
@@ -0,0 +1,152 @@
+package fakeapi
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// configurationKey: a broker configuration key, supported from minVersion and before maxVersion,
+// when set.
+type configurationKey struct {
+	value      any
+	minVersion string
+	maxVersion string
+}
+
+// rabbitMqConfigurationKeys: the supported RabbitMQ keys and their default values.
+var rabbitMqConfigurationKeys = map[string]configurationKey{
+	"rabbit.heartbeat":                                             {value: 120},
+	"rabbit.connection_max":                                        {value: "infinity"},
+	"rabbit.channel_max":                                           {value: 128},
+	"rabbit.consumer_timeout":                                      {value: 7200000},
+	"rabbit.vm_memory_high_watermark":                              {value: 0.81},
+	"rabbit.queue_index_embed_msgs_below":                          {value: 4096},
+	"rabbit.max_message_size":                                      {value: 134217728},
+	"rabbit.log.exchange.level":                                    {value: "error"},
+	"rabbit.cluster_partition_handling":                            {value: "autoheal"},
+	"rabbitmq_mqtt.exchange":                                       {value: "amq.topic"},
+	"rabbitmq_mqtt.ssl_cert_login":                                 {value: "false"},
+	"rabbitmq_mqtt.max_session_expiry_interval_seconds":            {value: 1800, minVersion: "3.13"},
+	"rabbit.ssl_cert_login_from":                                   {value: "distinguished_name"},
+	"rabbit.ssl_options.fail_if_no_peer_cert":                      {value: "false"},
+	"rabbit.ssl_options.verify":                                    {value: "verify_none"},
+	"message_interceptors.incoming.set_header_timestamp.overwrite": {value: "disabled"},
+	"rabbit.default_queue_type":                                    {value: "classic", minVersion: "3.13"},
+	"rabbit.quorum_cluster_size":                                   {value: 3},
+	"rabbit.quorum_commands_soft_limit":                            {value: 32},
+	"quorum_queue.continuous_membership_reconciliation.enabled":    {value: "false", minVersion: "3.13"},
+	"rabbitmq_stream.frame_max":                                    {value: 1048576, minVersion: "3.9"},
+	"rabbitmq_stream.heartbeat":                                    {value: 60, minVersion: "3.9"},
+	"rabbit.vm_memory_high_watermark_paging_ratio":                 {value: 0.5, maxVersion: "4.0"},
+	"rabbit.disk_free_limit":                                       {value: 50000000},
+	"rabbit.log.default.level":                                     {value: "info"},
+	"rabbit.log.connection.level":                                  {value: "info"},
+	"rabbitmq_mqtt.vhost":                                          {}, // defaults to the instance vhost
+}
+
+// lavinMqConfigurationKeys: the supported LavinMQ keys and their default values.
+var lavinMqConfigurationKeys = map[string]configurationKey{
+	"main.segment_size":              {value: 8388608},
+	"main.max_message_size":          {value: 134217728},
+	"main.default_consumer_prefetch": {value: 65535},
+	"amqp.heartbeat":                 {value: 300},
+	"amqp.channel_max":               {value: 2048},
+	"amqp.connection_max":            {value: 0},
+}
+
+// configurationKeys: the keys of the instance backend. Must hold the lock.
+func configurationKeys(inst *instance) map[string]configurationKey {
+	if inst.Backend == "lavinmq" {
+		return lavinMqConfigurationKeys
+	}
+	return rabbitMqConfigurationKeys
+}
+
+// supported: whether the key is supported by the broker version of the instance.
+func (k configurationKey) supported(inst *instance) bool {
+	if k.minVersion != "" && versionBefore(inst.RmqVersion, k.minVersion) {
+		return false
+	}
+	if k.maxVersion != "" && !versionBefore(inst.RmqVersion, k.maxVersion) {
+		return false
+	}
+	return true
+}
+
+// versionBefore: whether the dotted numeric version a is before b, missing parts count as 0.
+func versionBefore(a, b string) bool {
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(partsA), len(partsB)); i++ {
+		var x, y int
+		if i < len(partsA) {
+			x, _ = strconv.Atoi(partsA[i])
+		}
+		if i < len(partsB) {
+			y, _ = strconv.Atoi(partsB[i])
+		}
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// configurationResponse: the defaults for the backend and version overridden by the updated keys.
+// Must hold the lock.
+func configurationResponse(inst *instance) map[string]any {
+	data := map[string]any{}
+	for key, k := range configurationKeys(inst) {
+		if k.supported(inst) {
+			data[key] = k.value
+		}
+	}
+	if inst.Backend != "lavinmq" {
+		data["rabbitmq_mqtt.vhost"] = inst.username()
+	}
+	maps.Copy(data, inst.configuration)
+	return data
+}
+
+func (s *Server) registerConfiguration(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/instances/{id}/config", s.readConfiguration)
+	mux.HandleFunc("PUT /api/instances/{id}/config", s.updateConfiguration)
+}
+
+func (s *Server) readConfiguration(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, configurationResponse(inst))
+}
+
+// updateConfiguration: updates the keys sent, unknown or unsupported keys are rejected.
+func (s *Server) updateConfiguration(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	for key := range params {
+		if k, ok := configurationKeys(inst)[key]; !ok || !k.supported(inst) {
+			writeError(w, http.StatusBadRequest,
+				fmt.Sprintf("Configuration %s not supported by %s %s", key, inst.Backend, inst.RmqVersion))
+			return
+		}
+	}
+	maps.Copy(inst.configuration, params)
+	writeJSON(w, http.StatusOK, nil)
+}
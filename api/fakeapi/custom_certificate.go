@@ -0,0 +1,51 @@
+package fakeapi
+
+import (
+	"net/http"
+)
+
+// customCertificate: the uploaded custom certificate of the instance, certificates and private key
+// are write-only and never returned.
+type customCertificate struct {
+	SNIHosts string
+}
+
+func (s *Server) registerCustomCertificate(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/instances/{id}/custom-cert", s.createCustomCertificate)
+	mux.HandleFunc("DELETE /api/instances/{id}/custom-cert", s.deleteCustomCertificate)
+}
+
+func (s *Server) createCustomCertificate(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	sniHosts, _ := params["sni_hosts"].(string)
+	inst.customCertificate = &customCertificate{SNIHosts: sniHosts}
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "custom-cert", "create", "")})
+}
+
+// deleteCustomCertificate: restores the default certificate.
+func (s *Server) deleteCustomCertificate(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.customCertificate == nil {
+		writeNotFound(w)
+		return
+	}
+	inst.customCertificate = nil
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "custom-cert", "delete", "")})
+}
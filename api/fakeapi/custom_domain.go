@@ -0,0 +1,68 @@
+package fakeapi
+
+import (
+	"net/http"
+)
+
+// customDomain: the custom domain of the instance, the hostname is only returned once configured.
+type customDomain struct {
+	Hostname string
+	pending  int
+}
+
+func (s *Server) registerCustomDomain(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/instances/{id}/custom-domain", s.createCustomDomain)
+	mux.HandleFunc("GET /api/instances/{id}/custom-domain", s.readCustomDomain)
+	mux.HandleFunc("DELETE /api/instances/{id}/custom-domain", s.deleteCustomDomain)
+}
+
+func (s *Server) createCustomDomain(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	hostname, _ := params["hostname"].(string)
+	if hostname == "" {
+		writeError(w, http.StatusBadRequest, "Hostname is required")
+		return
+	}
+	inst.customDomain = &customDomain{Hostname: hostname, pending: s.opts.SettleAfter}
+	writeJSON(w, http.StatusOK, map[string]any{"status": "Custom domain added, certificate being generated"})
+}
+
+// readCustomDomain: the hostname is null and configured false until the custom domain is settled.
+func (s *Server) readCustomDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.customDomain == nil || !settle(&inst.customDomain.pending) {
+		writeJSON(w, http.StatusOK, map[string]any{"hostname": nil, "configured": false})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"hostname": inst.customDomain.Hostname, "configured": true})
+}
+
+// deleteCustomDomain: removes the custom domain and restores the default certificate.
+func (s *Server) deleteCustomDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	inst.customDomain = nil
+	writeJSON(w, http.StatusOK, map[string]any{"status": "Removing custom domain and restoring default certificate"})
+}
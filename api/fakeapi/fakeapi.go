@@ -0,0 +1,216 @@
+// Package fakeapi provides an in-process, stateful fake of the CloudAMQP customer API for offline
+// tests. Point api.New at Server.URL, or use Server.API, to run the provider against it without
+// cassettes or a real account.
+//
+// Instances, nodes, alarms, recipients, log and metric integrations, webhooks, trust stores,
+// custom domains, custom certificates, OAuth2 configurations, RabbitMQ configuration, feature
+// flags, firewall, VPCs, Azure VNet peerings and jobs are kept in memory.
+// Long running operations are eventually consistent: instances become ready, nodes, firewall and
+// custom domains configured, VPCs available, peerings connected and jobs completed after
+// Options.SettleAfter reads. Faults, e.g. 423, 429 or 503, can be injected for matching requests.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+)
+
+// Options configures the behaviour of the fake server.
+type Options struct {
+	// APIKey required as basic auth password, any key is accepted when empty.
+	APIKey string
+	// Latency added before each response is written.
+	Latency time.Duration
+	// SettleAfter number of reads an eventually consistent resource is reported as pending.
+	SettleAfter int
+}
+
+// Fault makes the server respond with a status code, instead of handling the request.
+type Fault struct {
+	// Method to match, any method when empty.
+	Method string
+	// Path regular expression to match against the request path, e.g. `/api/instances/\d+$`.
+	Path string
+	// StatusCode to respond with, e.g. 423, 429 or 503.
+	StatusCode int
+	// Body to respond with, a generic message is used when nil.
+	Body map[string]any
+	// Times number of matching requests to fail, every matching request when zero.
+	Times int
+}
+
+type fault struct {
+	Fault
+	path  *regexp.Regexp
+	count int
+}
+
+// Server is a fake CloudAMQP API served by a httptest.Server.
+type Server struct {
+	// URL of the fake API, e.g. http://127.0.0.1:8080
+	URL string
+
+	server *httptest.Server
+	opts   Options
+
+	mu        sync.Mutex
+	nextID    int64
+	faults    []*fault
+	requests  []string
+	instances map[int64]*instance
+	vpcs      map[int64]*vpc
+}
+
+// NewServer starts a fake API server, close it with Close when done.
+func NewServer(opts Options) *Server {
+	s := &Server{
+		opts:      opts,
+		nextID:    1000,
+		instances: make(map[int64]*instance),
+		vpcs:      make(map[int64]*vpc),
+	}
+
+	mux := http.NewServeMux()
+	s.registerMetadata(mux)
+	s.registerInstances(mux)
+	s.registerMonitoring(mux)
+	s.registerIntegrations(mux)
+	s.registerWebhooks(mux)
+	s.registerTrustStore(mux)
+	s.registerCustomDomain(mux)
+	s.registerCustomCertificate(mux)
+	s.registerOAuth2Configuration(mux)
+	s.registerConfiguration(mux)
+	s.registerFeatureFlags(mux)
+	s.registerFirewall(mux)
+	s.registerVpcs(mux)
+	s.registerJobs(mux)
+
+	s.server = httptest.NewServer(s.middleware(mux))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a HTTP client for the server.
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+// API returns an API client pointing at the server.
+func (s *Server) API() *api.API {
+	return api.New(s.URL, s.opts.APIKey, "fakeapi", s.Client())
+}
+
+// InjectFault adds a fault, evaluated in the order added, before the request is handled.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{Fault: f, path: regexp.MustCompile(f.Path)})
+}
+
+// Requests returns all received requests, formatted as "<method> <path>", in order.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.opts.Latency > 0 {
+			time.Sleep(s.opts.Latency)
+		}
+
+		s.mu.Lock()
+		s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		f := s.matchFault(r)
+		s.mu.Unlock()
+
+		if s.opts.APIKey != "" {
+			if _, password, ok := r.BasicAuth(); !ok || password != s.opts.APIKey {
+				writeJSON(w, http.StatusUnauthorized, map[string]any{"message": "Unauthorized"})
+				return
+			}
+		}
+
+		if f != nil {
+			body := f.Body
+			if body == nil {
+				body = map[string]any{"message": http.StatusText(f.StatusCode)}
+			}
+			writeJSON(w, f.StatusCode, body)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// matchFault: returns the first matching fault with attempts left, must hold the lock.
+func (s *Server) matchFault(r *http.Request) *fault {
+	for _, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !f.path.MatchString(r.URL.Path) {
+			continue
+		}
+		if f.Times > 0 && f.count >= f.Times {
+			continue
+		}
+		f.count++
+		return f
+	}
+	return nil
+}
+
+// newID: next unique identifier, must hold the lock.
+func (s *Server) newID() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+// settle: decrements pending reads, returns true once the resource is settled.
+func settle(pending *int) bool {
+	if *pending > 0 {
+		*pending--
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]any{"error": message, "message": message})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not found")
+}
+
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,569 @@
+package fakeapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance/configuration"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/integrations"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/monitoring"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+)
+
+func createInstance(t *testing.T, server *fakeapi.Server, plan string) int64 {
+	t.Helper()
+
+	data, err := server.API().CreateInstance(context.Background(), map[string]any{
+		"name":   "fake",
+		"plan":   plan,
+		"region": "amazon-web-services::us-east-1",
+		"tags":   []string{"terraform"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	instanceID, err := strconv.ParseInt(data["id"].(string), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return instanceID
+}
+
+func TestInstance(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{APIKey: "secret"})
+	defer server.Close()
+
+	ctx := context.Background()
+	instanceID := createInstance(t, server, "bunny-3")
+	id := strconv.FormatInt(instanceID, 10)
+
+	data, err := server.API().ReadInstance(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data["ready"] != true || data["nodes"] != float64(3) || data["backend"] != "rabbitmq" {
+		t.Fatalf("unexpected instance: %v", data)
+	}
+
+	nodes, err := server.API().ListNodes(ctx, instanceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 3 || !nodes[0].Configured || !nodes[0].Running {
+		t.Fatalf("unexpected nodes: %+v", nodes)
+	}
+
+	if err := server.API().UpdateInstance(ctx, id, map[string]any{"nodes": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if nodes, _ = server.API().ListNodes(ctx, instanceID); len(nodes) != 1 {
+		t.Fatalf("expected 1 node after update, got %d", len(nodes))
+	}
+
+	if err := server.API().DeleteInstance(ctx, id, false); err != nil {
+		t.Fatal(err)
+	}
+	if data, err = server.API().ReadInstance(ctx, id); err != nil || data != nil {
+		t.Fatalf("expected instance to be deleted, data=%v err=%v", data, err)
+	}
+}
+
+func TestEventualConsistency(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{SettleAfter: 2})
+	defer server.Close()
+
+	var (
+		ctx = context.Background()
+		a   = server.API()
+	)
+
+	// CreateInstance polls every 10 seconds until ready, create the instance directly instead.
+	resp, err := server.Client().Post(server.URL+"/api/instances", "application/json",
+		strings.NewReader(`{"name":"fake","plan":"bunny-1","region":"amazon-web-services::us-east-1"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var created struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+
+	instanceID := created.ID
+	for i, expected := range []bool{false, false, true} {
+		data, err := a.ReadInstance(ctx, strconv.FormatInt(instanceID, 10))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data["ready"] != expected {
+			t.Fatalf("read %d: expected ready=%t, got %v", i, expected, data["ready"])
+		}
+	}
+
+	for i, expected := range []bool{false, false, true} {
+		nodes, err := a.ListNodes(ctx, instanceID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nodes[0].Configured != expected {
+			t.Fatalf("read %d: expected configured=%t, got %t", i, expected, nodes[0].Configured)
+		}
+	}
+
+	jobID, err := server.NewJob(instanceID, "plugin", "enable", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expected := range []string{"pending", "pending", "completed"} {
+		job, err := a.ReadJob(ctx, instanceID, jobID, time.Millisecond)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *job.Status != expected {
+			t.Fatalf("read %d: expected status=%s, got %s", i, expected, *job.Status)
+		}
+	}
+}
+
+func TestMonitoring(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		ctx        = context.Background()
+		a          = server.API()
+		instanceID = createInstance(t, server, "bunny-1")
+	)
+
+	alarms, err := a.ListAlarms(ctx, instanceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alarms) != 4 {
+		t.Fatalf("expected 4 default alarms, got %d", len(alarms))
+	}
+
+	recipient, err := a.CreateNotification(ctx, instanceID, &monitoring.RecipientRequest{
+		Type:  "webhook",
+		Value: "https://example.com/webhook",
+		Name:  "webhook",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	threshold := int64(1000)
+	recipients := []int64{recipient.ID}
+	alarmID, err := a.CreateAlarm(ctx, instanceID, monitoring.AlarmRequest{
+		Type:           "queue",
+		Enabled:        true,
+		ValueThreshold: &threshold,
+		QueueRegex:     ".*",
+		Recipients:     &recipients,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alarm, err := a.ReadAlarm(ctx, instanceID, alarmID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alarm == nil || alarm.Type != "queue" || *alarm.QueueRegex != ".*" || (*alarm.Recipients)[0] != recipient.ID {
+		t.Fatalf("unexpected alarm: %+v", alarm)
+	}
+
+	if err := a.DeleteAlarm(ctx, instanceID, alarmID); err != nil {
+		t.Fatal(err)
+	}
+	if alarm, err = a.ReadAlarm(ctx, instanceID, alarmID); err != nil || alarm != nil {
+		t.Fatalf("expected alarm to be deleted, alarm=%+v err=%v", alarm, err)
+	}
+}
+
+func TestIntegrations(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		ctx        = context.Background()
+		a          = server.API()
+		instanceID = createInstance(t, server, "bunny-1")
+	)
+
+	logID, err := a.CreateIntegrationLog(ctx, instanceID, "papertrail", integrations.LogRequest{
+		URL: "logs.papertrailapp.com:11111",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	log, err := a.ReadIntegrationLog(ctx, instanceID, logID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log == nil || log.Type != "papertrail" || *log.Config.URL != "logs.papertrailapp.com:11111" {
+		t.Fatalf("unexpected log integration: %+v", log)
+	}
+
+	metricID, err := a.CreateIntegrationMetric(ctx, instanceID, "datadog_v2",
+		integrations.MetricRequest{APIKey: "key", Region: "us1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.UpdateMetricsFilter(ctx, int(instanceID), metricID, []string{"queue_depth"}); err != nil {
+		t.Fatal(err)
+	}
+	metric, err := a.ReadIntegration(ctx, int(instanceID), "metrics", metricID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metric["region"] != "us1" || len(metric["metrics_filter"].([]any)) != 1 {
+		t.Fatalf("unexpected metric integration: %v", metric)
+	}
+
+	if err := a.DeleteIntegrationLog(ctx, instanceID, logID); err != nil {
+		t.Fatal(err)
+	}
+	if log, err = a.ReadIntegrationLog(ctx, instanceID, logID); err != nil || log != nil {
+		t.Fatalf("expected log integration to be deleted, log=%+v err=%v", log, err)
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		ctx        = context.Background()
+		a          = server.API()
+		instanceID = createInstance(t, server, "bunny-1")
+	)
+
+	id, err := a.CreateWebhook(ctx, instanceID, integrations.WebhookCreateRequest{
+		Concurrency: 1,
+		WebhookURI:  "https://example.com/webhook",
+		Vhost:       "vhost",
+		Queue:       "queue",
+	}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhook, err := a.ReadWebhook(ctx, instanceID, id, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook == nil || webhook.Queue != "queue" || webhook.LastStatus != nil {
+		t.Fatalf("unexpected webhook: %+v", webhook)
+	}
+
+	webhookID, _ := strconv.ParseInt(id, 10, 64)
+	err = a.UpdateWebhook(ctx, instanceID, id, integrations.WebhookUpdateRequest{
+		WebhookID:   webhookID,
+		Concurrency: 2,
+		WebhookURI:  "https://example.com/webhook",
+		Vhost:       "vhost",
+		Queue:       "queue",
+	}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhooks, err := a.ListWebhooks(ctx, instanceID, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 1 || webhooks[0].Concurrency != 2 {
+		t.Fatalf("unexpected webhooks: %+v", webhooks)
+	}
+
+	if err := a.DeleteWebhook(ctx, instanceID, id, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if webhook, err = a.ReadWebhook(ctx, instanceID, id, time.Second); err != nil || webhook != nil {
+		t.Fatalf("expected webhook to be deleted, webhook=%+v err=%v", webhook, err)
+	}
+}
+
+func TestCustomDomain(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		a          = server.API()
+		instanceID = createInstance(t, server, "bunny-1")
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	data, err := a.ReadCustomDomain(ctx, instanceID, time.Millisecond)
+	if err != nil || data.Hostname != nil || data.Configured {
+		t.Fatalf("expected no custom domain, data=%+v err=%v", data, err)
+	}
+
+	data, err = a.CreateCustomDomain(ctx, instanceID, "test.example.com", true, time.Millisecond)
+	if err != nil || data.Hostname == nil || *data.Hostname != "test.example.com" || !data.Configured {
+		t.Fatalf("unexpected custom domain, data=%+v err=%v", data, err)
+	}
+
+	if data, err = a.DeleteCustomDomain(ctx, instanceID, time.Millisecond); err != nil || data.Configured {
+		t.Fatalf("expected custom domain to be deleted, data=%+v err=%v", data, err)
+	}
+}
+
+func TestFeatureFlags(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		a          = server.API()
+		instanceID = createInstance(t, server, "bunny-1")
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := a.EnableFeatureFlags(ctx, instanceID, instance.FeatureFlagsRequest{
+		Enable: []string{"unknown_flag"},
+	}); err == nil {
+		t.Fatal("expected unknown feature flag to be rejected")
+	}
+
+	job, err := a.EnableFeatureFlags(ctx, instanceID, instance.FeatureFlagsRequest{
+		Enable: []string{"khepri_db"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.PollForJobCompleted(ctx, instanceID, *job.ID, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	flags, err := a.ListFeatureFlags(ctx, instanceID, time.Millisecond)
+	if err != nil || len(flags) != 5 {
+		t.Fatalf("unexpected feature flags, flags=%+v err=%v", flags, err)
+	}
+	if flags[1].Name != "khepri_db" || flags[1].State != "enabled" || flags[2].State != "disabled" {
+		t.Fatalf("expected only khepri_db to be enabled, got %+v", flags)
+	}
+
+	if err := a.DeleteInstance(ctx, strconv.FormatInt(instanceID, 10), false); err != nil {
+		t.Fatal(err)
+	}
+	if flags, err = a.ListFeatureFlags(ctx, instanceID, time.Millisecond); err != nil || flags != nil {
+		t.Fatalf("expected no feature flags for deleted instance, flags=%+v err=%v", flags, err)
+	}
+}
+
+func TestConfiguration(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		a   = server.API()
+		ctx = context.Background()
+	)
+	data, err := a.CreateInstance(ctx, map[string]any{
+		"name":        "fake",
+		"plan":        "bunny-1",
+		"region":      "amazon-web-services::us-east-1",
+		"rmq_version": "3.12.13",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	instanceID, _ := strconv.ParseInt(data["id"].(string), 10, 64)
+
+	config, err := a.ReadRabbitMqConfiguration(ctx, instanceID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Heartbeat != 120 || config.VmMemoryHighWatermarkPagingRatio == nil ||
+		config.DefaultQueueType != nil || config.MQTTMaxSessionExpiryIntervalSeconds != nil {
+		t.Fatalf("unexpected configuration for RabbitMQ 3.12: %+v", config)
+	}
+
+	if err := a.UpdateRabbitMqConfiguration(ctx, instanceID, configuration.RabbitMqConfigRequest{
+		DefaultQueueType: utils.Pointer("quorum"),
+	}, 0); err == nil {
+		t.Fatal("expected error updating a key not supported by RabbitMQ 3.12")
+	}
+	if err := a.UpdateRabbitMqConfiguration(ctx, instanceID, configuration.RabbitMqConfigRequest{
+		QuorumClusterSize: utils.Pointer(int64(5)),
+	}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if config, _ = a.ReadRabbitMqConfiguration(ctx, instanceID, 0); *config.QuorumClusterSize != 5 {
+		t.Fatalf("expected quorum_cluster_size 5, got %d", *config.QuorumClusterSize)
+	}
+
+	instanceID = createInstance(t, server, "bunny-1")
+	config, err = a.ReadRabbitMqConfiguration(ctx, instanceID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.VmMemoryHighWatermarkPagingRatio != nil || *config.DefaultQueueType != "classic" {
+		t.Fatalf("unexpected configuration for RabbitMQ 4.1: %+v", config)
+	}
+
+	instanceID = createInstance(t, server, "penguin-1")
+	if err := a.UpdateRabbitMqConfiguration(ctx, instanceID, configuration.RabbitMqConfigRequest{
+		Heartbeat: utils.Pointer(int64(60)),
+	}, 0); err == nil {
+		t.Fatal("expected error updating a RabbitMQ key on LavinMQ")
+	}
+	if err := a.UpdateLavinMqConfiguration(ctx, instanceID, configuration.LavinMqConfigRequest{
+		Heartbeat: utils.Pointer(int64(60)),
+	}, 0); err != nil {
+		t.Fatal(err)
+	}
+	lavinMqConfig, err := a.ReadLavinMqConfiguration(ctx, instanceID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lavinMqConfig.Heartbeat != 60 || lavinMqConfig.SegmentSize != 8388608 {
+		t.Fatalf("unexpected configuration for LavinMQ: %+v", lavinMqConfig)
+	}
+}
+
+func TestFirewallAndVpc(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		ctx        = context.Background()
+		a          = server.API()
+		instanceID = createInstance(t, server, "bunny-1")
+	)
+
+	rules, err := a.UpdateFirewallSettings(ctx, int(instanceID), []map[string]any{
+		{"ip": "10.56.72.0/24", "services": []string{"AMQPS"}, "ports": []int{}},
+	}, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || rules[0]["ip"] != "10.56.72.0/24" {
+		t.Fatalf("unexpected firewall rules: %v", rules)
+	}
+
+	vpc, err := a.CreateVPC(ctx, network.VpcRequest{
+		Name:   "fake",
+		Region: "amazon-web-services::us-east-1",
+		Subnet: "10.56.72.0/24",
+		Tags:   []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vpc.VpcName == "" {
+		t.Fatalf("expected VPC name, got: %+v", vpc)
+	}
+
+	vpcID := strconv.Itoa(vpc.ID)
+	peering, err := a.RequestVpcAzurePeering(ctx, 0, vpcID, network.AzurePeeringRequest{
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:  "rg-fake",
+		VnetName:       "vnet-fake",
+	}, true, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if azurePeering, err := a.ReadVpcAzurePeering(ctx, 0, vpcID, peering, 1, 10); err != nil ||
+		azurePeering == nil || azurePeering.PeeringState != "Connected" {
+		t.Fatalf("expected connected VNet peering, peering=%+v err=%v", azurePeering, err)
+	}
+	if err := a.RemoveVpcAzurePeering(ctx, 0, vpcID, peering, 1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if azurePeering, err := a.ReadVpcAzurePeering(ctx, 0, vpcID, peering, 1, 10); err != nil ||
+		azurePeering != nil {
+		t.Fatalf("expected VNet peering to be removed, peering=%+v err=%v", azurePeering, err)
+	}
+	if err := a.DeleteVPC(ctx, vpc.ID); err != nil {
+		t.Fatal(err)
+	}
+	if read, err := a.ReadVPC(ctx, vpc.ID); err != nil || read != nil {
+		t.Fatalf("expected VPC to be deleted, vpc=%+v err=%v", read, err)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		a          = server.API()
+		instanceID = createInstance(t, server, "bunny-1")
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobID, err := server.NewJob(instanceID, "plugin", "enable", "plugin failed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, statusCode := range []int{423, 429, 503} {
+		server.InjectFault(fakeapi.Fault{
+			Method:     "GET",
+			Path:       `/jobs/`,
+			StatusCode: statusCode,
+			Times:      1,
+		})
+	}
+
+	job, err := a.ReadJob(ctx, instanceID, jobID, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *job.Status != "failed" || *job.ErrorMessage != "plugin failed" {
+		t.Fatalf("unexpected job: %+v", job)
+	}
+
+	jobRequests := 0
+	for _, request := range server.Requests() {
+		if request == "GET /api/instances/"+strconv.FormatInt(instanceID, 10)+"/jobs/"+jobID {
+			jobRequests++
+		}
+	}
+	if jobRequests != 4 {
+		t.Fatalf("expected 4 job requests, got %d", jobRequests)
+	}
+
+	server.InjectFault(fakeapi.Fault{Path: `/nodes$`, StatusCode: 500})
+	if _, err := a.ListNodes(ctx, instanceID); err == nil {
+		t.Fatal("expected error from injected fault")
+	}
+}
+
+func TestLatencyAndAuthentication(t *testing.T) {
+	server := fakeapi.NewServer(fakeapi.Options{APIKey: "secret", Latency: 50 * time.Millisecond})
+	defer server.Close()
+
+	start := time.Now()
+	if err := server.API().ValidatePlan(context.Background(), "bunny-1"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("expected latency of at least 50ms, got %s", elapsed)
+	}
+
+	resp, err := server.Client().Get(server.URL + "/api/plans")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 401 {
+		t.Fatalf("expected unauthorized without API key, got %d", resp.StatusCode)
+	}
+}
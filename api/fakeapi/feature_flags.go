@@ -0,0 +1,68 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance"
+)
+
+// defaultFeatureFlags: the feature flags of a new instance, in the order listed by the API.
+func defaultFeatureFlags() []model.FeatureFlagResponse {
+	return []model.FeatureFlagResponse{
+		{Name: "classic_mirrored_queue_version", State: "enabled", Stability: "required"},
+		{Name: "khepri_db", State: "disabled", Stability: "experimental"},
+		{Name: "message_containers", State: "disabled", Stability: "stable"},
+		{Name: "quorum_queue_non_voters", State: "disabled", Stability: "stable"},
+		{Name: "stream_filtering", State: "disabled", Stability: "stable"},
+	}
+}
+
+func (s *Server) registerFeatureFlags(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/instances/{id}/feature-flags", s.listFeatureFlags)
+	mux.HandleFunc("POST /api/instances/{id}/feature-flags", s.enableFeatureFlags)
+}
+
+func (s *Server) listFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, inst.featureFlags)
+}
+
+// enableFeatureFlags: enables the listed feature flags, or all stable ones, unknown feature flags
+// are rejected. Feature flags can't be disabled once enabled.
+func (s *Server) enableFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	var params model.FeatureFlagsRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	for _, name := range params.Enable {
+		if !slices.ContainsFunc(inst.featureFlags, func(flag model.FeatureFlagResponse) bool {
+			return flag.Name == name
+		}) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Unknown feature flag: %s", name))
+			return
+		}
+	}
+	for i, flag := range inst.featureFlags {
+		if slices.Contains(params.Enable, flag.Name) ||
+			(params.EnableAllStable && flag.Stability == "stable") {
+			inst.featureFlags[i].State = "enabled"
+		}
+	}
+	writeJSON(w, http.StatusAccepted, map[string]any{"job_id": s.newJob(inst, "feature-flags", "enable", "")})
+}
@@ -0,0 +1,81 @@
+package fakeapi
+
+import (
+	"net/http"
+)
+
+func defaultFirewall() []map[string]any {
+	return []map[string]any{
+		{
+			"ip":          "0.0.0.0/0",
+			"ports":       []any{},
+			"services":    []any{"AMQP", "AMQPS", "HTTPS", "STREAM", "STREAM_SSL"},
+			"description": "Default",
+		},
+	}
+}
+
+func (s *Server) registerFirewall(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/instances/{id}/security/firewall", s.readFirewall)
+	mux.HandleFunc("POST /api/instances/{id}/security/firewall", s.updateFirewall)
+	mux.HandleFunc("PUT /api/instances/{id}/security/firewall", s.updateFirewall)
+	mux.HandleFunc("GET /api/instances/{id}/security/firewall/configured", s.firewallConfigured)
+}
+
+func (s *Server) readFirewall(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, inst.firewall)
+}
+
+func (s *Server) updateFirewall(w http.ResponseWriter, r *http.Request) {
+	var params []map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	for _, rule := range params {
+		if ip, _ := rule["ip"].(string); ip == "" {
+			writeJSON(w, http.StatusBadRequest, map[string]any{
+				"error_code": 40002,
+				"error":      "ip is required for all rules",
+			})
+			return
+		}
+	}
+
+	inst.firewall = params
+	inst.firewallPending = s.opts.SettleAfter
+	writeJSON(w, http.StatusCreated, nil)
+}
+
+// firewallConfigured: responds with bad request and error code 40001 until configured.
+func (s *Server) firewallConfigured(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if !settle(&inst.firewallPending) {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"error_code": 40001,
+			"error":      "Firewall not finished configuring",
+		})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"configured": true})
+}
@@ -0,0 +1,343 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	instancemodel "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance/node"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/monitoring"
+)
+
+type instance struct {
+	ID         int64
+	Name       string
+	Plan       string
+	Region     string
+	Backend    string
+	RmqVersion string
+	Tags       []string
+	VpcID      int64
+	pending    int
+
+	nodes        []*node
+	nodesPending int
+
+	alarms       map[int64]*monitoring.AlarmResponse
+	recipients   map[int64]*monitoring.RecipientResponse
+	integrations map[string]map[int64]*integration
+	webhooks     map[int64]*webhook
+	trustStore   *trustStore
+
+	customDomain        *customDomain
+	customCertificate   *customCertificate
+	oauth2Configuration *oauth2Configuration
+	configuration       map[string]any
+	featureFlags        []instancemodel.FeatureFlagResponse
+
+	firewall        []map[string]any
+	firewallPending int
+
+	jobs map[string]*job
+}
+
+type node struct {
+	model.NodeResponse
+	pendingRunning *bool
+	actionPending  int
+}
+
+func (i *instance) hostname() string {
+	return fmt.Sprintf("fake-%d.rmq.cloudamqp.com", i.ID)
+}
+
+func (i *instance) hostnameInternal() string {
+	return fmt.Sprintf("fake-%d.in.rmq.cloudamqp.com", i.ID)
+}
+
+func (i *instance) username() string {
+	return fmt.Sprintf("fake%d", i.ID)
+}
+
+func (i *instance) response(ready bool) map[string]any {
+	url := fmt.Sprintf("amqps://%s:password@%s/%s", i.username(), i.hostname(), i.username())
+	data := map[string]any{
+		"id":          i.ID,
+		"name":        i.Name,
+		"plan":        i.Plan,
+		"region":      i.Region,
+		"tags":        i.Tags,
+		"providerid":  fmt.Sprintf("00000000-0000-4000-8000-%012d", i.ID),
+		"url":         url,
+		"ready":       ready,
+		"apikey":      fmt.Sprintf("00000000-0000-4000-9000-%012d", i.ID),
+		"backend":     i.Backend,
+		"nodes":       len(i.nodes),
+		"rmq_version": i.RmqVersion,
+		"urls": map[string]any{
+			"external": url,
+			"internal": fmt.Sprintf("amqp://%s:password@%s/%s", i.username(), i.hostnameInternal(),
+				i.username()),
+		},
+		"hostname_external": i.hostname(),
+		"hostname_internal": i.hostnameInternal(),
+	}
+	if i.VpcID != 0 {
+		data["vpc"] = map[string]any{"id": i.VpcID}
+	}
+	return data
+}
+
+// setNodes: resizes the cluster, new nodes are started and configured once settled.
+func (i *instance) setNodes(count int) {
+	for len(i.nodes) > count {
+		i.nodes = i.nodes[:len(i.nodes)-1]
+	}
+	for n := len(i.nodes) + 1; n <= count; n++ {
+		name := fmt.Sprintf("fake-%d-%02d", i.ID, n)
+		i.nodes = append(i.nodes, &node{NodeResponse: model.NodeResponse{
+			Name:             name,
+			Hostname:         fmt.Sprintf("%s.rmq.cloudamqp.com", name),
+			HostnameInternal: fmt.Sprintf("%s.in.rmq.cloudamqp.com", name),
+			Running:          true,
+			RabbitMqVersion:  i.RmqVersion,
+			ErlangVersion:    "27.3",
+			DiskSize:         20,
+			AvailabilityZone: fmt.Sprintf("%s%c", i.Region, 'a'+rune(n-1)),
+		}})
+	}
+}
+
+// instance: looks up the instance from the path, writes not found if missing. Must hold the lock.
+func (s *Server) instance(w http.ResponseWriter, r *http.Request) (*instance, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeNotFound(w)
+		return nil, false
+	}
+	inst, ok := s.instances[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return inst, true
+}
+
+func (s *Server) registerInstances(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/instances", s.createInstance)
+	mux.HandleFunc("GET /api/instances/{id}", s.readInstance)
+	mux.HandleFunc("PUT /api/instances/{id}", s.updateInstance)
+	mux.HandleFunc("DELETE /api/instances/{id}", s.deleteInstance)
+	mux.HandleFunc("GET /api/instances/{id}/nodes", s.listNodes)
+	mux.HandleFunc("POST /api/instances/{id}/actions/{action}", s.nodeAction)
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name, _ := params["name"].(string)
+	planName, _ := params["plan"].(string)
+	region, _ := params["region"].(string)
+	if name == "" || region == "" {
+		writeError(w, http.StatusBadRequest, "name and region are required")
+		return
+	}
+	plan, ok := findPlan(planName)
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid plan: %s", planName))
+		return
+	}
+
+	inst := &instance{
+		ID:            s.newID(),
+		Name:          name,
+		Plan:          plan.Name,
+		Region:        region,
+		Backend:       plan.Backend,
+		RmqVersion:    "4.1.6",
+		Tags:          toStrings(params["tags"]),
+		pending:       s.opts.SettleAfter,
+		nodesPending:  s.opts.SettleAfter,
+		alarms:        make(map[int64]*monitoring.AlarmResponse),
+		recipients:    make(map[int64]*monitoring.RecipientResponse),
+		integrations:  map[string]map[int64]*integration{"logs": {}, "metrics": {}},
+		webhooks:      make(map[int64]*webhook),
+		configuration: make(map[string]any),
+		featureFlags:  defaultFeatureFlags(),
+		firewall:      defaultFirewall(),
+		jobs:          make(map[string]*job),
+	}
+	if v, ok := params["rmq_version"].(string); ok && v != "" {
+		inst.RmqVersion = v
+	}
+	if v, ok := params["vpc_id"].(float64); ok {
+		inst.VpcID = int64(v)
+	}
+
+	if !plan.Shared {
+		nodes := planNodes(plan.Name)
+		if v, ok := params["nodes"].(float64); ok && v > 0 {
+			nodes = int(v)
+		}
+		inst.setNodes(nodes)
+	}
+	if noDefaultAlarms, _ := params["no_default_alarms"].(bool); !noDefaultAlarms {
+		s.addDefaultAlarms(inst)
+	}
+
+	s.instances[inst.ID] = inst
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":     inst.ID,
+		"url":    inst.response(false)["url"],
+		"apikey": inst.response(false)["apikey"],
+	})
+}
+
+func (s *Server) readInstance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, inst.response(settle(&inst.pending)))
+}
+
+func (s *Server) updateInstance(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+
+	if v, ok := params["name"].(string); ok && v != "" {
+		inst.Name = v
+	}
+	if v, ok := params["tags"]; ok {
+		inst.Tags = toStrings(v)
+	}
+	if v, ok := params["plan"].(string); ok && v != "" {
+		plan, found := findPlan(v)
+		if !found {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid plan: %s", v))
+			return
+		}
+		inst.Plan = plan.Name
+		inst.Backend = plan.Backend
+	}
+	if v, ok := params["nodes"].(float64); ok && v > 0 {
+		inst.setNodes(int(v))
+	}
+	inst.nodesPending = s.opts.SettleAfter
+	writeJSON(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	delete(s.instances, inst.ID)
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (s *Server) listNodes(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+
+	configured := settle(&inst.nodesPending)
+	nodes := make([]model.NodeResponse, 0, len(inst.nodes))
+	for _, n := range inst.nodes {
+		if n.pendingRunning != nil && settle(&n.actionPending) {
+			n.Running = *n.pendingRunning
+			n.pendingRunning = nil
+		}
+		n.Configured = configured
+		nodes = append(nodes, n.NodeResponse)
+	}
+	writeJSON(w, http.StatusOK, nodes)
+}
+
+func (s *Server) nodeAction(w http.ResponseWriter, r *http.Request) {
+	var params model.NodeActionRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+
+	var running bool
+	switch action := r.PathValue("action"); action {
+	case "start", "restart", "reboot", "mgmt-restart", "cluster-restart", "cluster-start":
+		running = true
+	case "stop", "cluster-stop":
+		running = false
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Unknown action: %s", action))
+		return
+	}
+
+	targets := make(map[string]bool)
+	for _, name := range params.Nodes {
+		targets[name] = true
+	}
+	for _, n := range inst.nodes {
+		if len(targets) > 0 && !targets[n.Name] {
+			continue
+		}
+		n.pendingRunning = &running
+		n.actionPending = s.opts.SettleAfter
+	}
+	writeJSON(w, http.StatusOK, map[string]any{})
+}
+
+// planNodes: number of nodes from the plan name suffix, e.g. bunny-3.
+func planNodes(plan string) int {
+	if i := strings.LastIndex(plan, "-"); i != -1 {
+		if nodes, err := strconv.Atoi(plan[i+1:]); err == nil {
+			return nodes
+		}
+	}
+	return 1
+}
+
+func toStrings(v any) []string {
+	values := []string{}
+	list, _ := v.([]any)
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,177 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+)
+
+type integration struct {
+	ID            int64          `json:"id"`
+	Type          string         `json:"type"`
+	Config        map[string]any `json:"config"`
+	MetricsFilter []string       `json:"metrics_filter,omitempty"`
+}
+
+// configure: sets the configuration, the metrics filter is part of the request but returned
+// next to the configuration.
+func (i *integration) configure(params map[string]any) {
+	if metricsFilter, ok := params["metrics_filter"]; ok {
+		i.MetricsFilter = toStrings(metricsFilter)
+		delete(params, "metrics_filter")
+	}
+	i.Config = params
+}
+
+func (s *Server) registerIntegrations(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/instances/{id}/integrations/{kind}", s.listIntegrations)
+	mux.HandleFunc("POST /api/instances/{id}/integrations/{kind}/{name}", s.createIntegration)
+	mux.HandleFunc("GET /api/instances/{id}/integrations/{kind}/{int_id}", s.readIntegration)
+	mux.HandleFunc("PUT /api/instances/{id}/integrations/{kind}/{int_id}", s.updateIntegration)
+	mux.HandleFunc("DELETE /api/instances/{id}/integrations/{kind}/{int_id}", s.deleteIntegration)
+	mux.HandleFunc("PUT /api/instances/{id}/integrations/metrics/{int_id}/metrics_filter",
+		s.updateMetricsFilter)
+}
+
+// integrations: looks up the integrations of the kind, logs or metrics, from the path. Must hold
+// the lock.
+func (s *Server) integrations(w http.ResponseWriter, r *http.Request) (map[int64]*integration, bool) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return nil, false
+	}
+	integrations, ok := inst.integrations[r.PathValue("kind")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return integrations, true
+}
+
+// integration: looks up a single integration from the path. Must hold the lock.
+func (s *Server) integration(w http.ResponseWriter, r *http.Request) (map[int64]*integration,
+	*integration, bool) {
+
+	integrations, ok := s.integrations(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	intID, ok := pathID(w, r, "int_id")
+	if !ok {
+		return nil, nil, false
+	}
+	integration, ok := integrations[intID]
+	if !ok {
+		writeNotFound(w)
+		return nil, nil, false
+	}
+	return integrations, integration, true
+}
+
+func (s *Server) listIntegrations(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	integrations, ok := s.integrations(w, r)
+	if !ok {
+		return
+	}
+	rows := make([]integration, 0, len(integrations))
+	for _, integration := range integrations {
+		rows = append(rows, *integration)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+	writeJSON(w, http.StatusOK, rows)
+}
+
+func (s *Server) createIntegration(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	integrations, ok := s.integrations(w, r)
+	if !ok {
+		return
+	}
+	name := r.PathValue("name")
+	for _, existing := range integrations {
+		if existing.Type == name {
+			writeError(w, http.StatusBadRequest, "Integration already exists")
+			return
+		}
+	}
+
+	integration := &integration{ID: s.newID(), Type: name}
+	integration.configure(params)
+	integrations[integration.ID] = integration
+	writeJSON(w, http.StatusCreated, map[string]any{"id": integration.ID})
+}
+
+func (s *Server) readIntegration(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, integration, ok := s.integration(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, integration)
+}
+
+func (s *Server) updateIntegration(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, integration, ok := s.integration(w, r)
+	if !ok {
+		return
+	}
+	// The OpenTelemetry headers and resource attributes are kept when omitted from the update.
+	for _, key := range []string{"headers", "resource_attributes"} {
+		if _, set := params[key]; !set && integration.Config[key] != nil {
+			params[key] = integration.Config[key]
+		}
+	}
+	integration.configure(params)
+	writeJSON(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteIntegration(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	integrations, integration, ok := s.integration(w, r)
+	if !ok {
+		return
+	}
+	delete(integrations, integration.ID)
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (s *Server) updateMetricsFilter(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		MetricsFilter []string `json:"metrics_filter"`
+	}
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r.SetPathValue("kind", "metrics")
+	_, integration, ok := s.integration(w, r)
+	if !ok {
+		return
+	}
+	integration.MetricsFilter = params.MetricsFilter
+	writeJSON(w, http.StatusOK, nil)
+}
@@ -0,0 +1,85 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/job"
+)
+
+type job struct {
+	model.JobResponse
+	errorMessage string
+	pending      int
+}
+
+func (s *Server) registerJobs(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/instances/{id}/jobs/{job_id}", s.readJob)
+}
+
+// NewJob adds a pending job to the instance and returns the job identifier. The job is completed
+// once settled, or failed with the error message when not empty.
+func (s *Server) NewJob(instanceID int64, resourceType, action, errorMessage string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instances[instanceID]
+	if !ok {
+		return "", fmt.Errorf("instance %d not found", instanceID)
+	}
+	return s.newJob(inst, resourceType, action, errorMessage), nil
+}
+
+// newJob: adds a pending job to the instance. Must hold the lock.
+func (s *Server) newJob(inst *instance, resourceType, action, errorMessage string) string {
+	var (
+		id         = fmt.Sprintf("00000000-0000-4000-8000-%012d", s.newID())
+		status     = "pending"
+		accountID  = "00000000-0000-4000-8000-000000000000"
+		resourceID = strconv.FormatInt(inst.ID, 10)
+		now        = time.Now().UTC()
+	)
+	inst.jobs[id] = &job{
+		JobResponse: model.JobResponse{
+			ID:             &id,
+			Status:         &status,
+			AccountId:      &accountID,
+			ResourceId:     &resourceID,
+			ResourceType:   &resourceType,
+			ResourceAction: &action,
+			CreatedAt:      &now,
+			UpdatedAt:      &now,
+		},
+		errorMessage: errorMessage,
+		pending:      s.opts.SettleAfter,
+	}
+	return id
+}
+
+func (s *Server) readJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	j, ok := inst.jobs[r.PathValue("job_id")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	if *j.Status == "pending" && settle(&j.pending) {
+		status, now := "completed", time.Now().UTC()
+		if j.errorMessage != "" {
+			status = "failed"
+			j.ErrorMessage = &j.errorMessage
+		}
+		j.Status = &status
+		j.UpdatedAt = &now
+	}
+	writeJSON(w, http.StatusOK, j.JobResponse)
+}
@@ -0,0 +1,47 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+)
+
+var plans = []api.Plan{
+	{Name: "lemur", Backend: "rabbitmq", Shared: true},
+	{Name: "tiger", Backend: "rabbitmq", Shared: true},
+	{Name: "lemming", Backend: "lavinmq", Shared: true},
+	{Name: "ermine", Backend: "lavinmq", Shared: true},
+	{Name: "squirrel-1", Backend: "rabbitmq", Shared: false},
+	{Name: "bunny-1", Backend: "rabbitmq", Shared: false},
+	{Name: "bunny-3", Backend: "rabbitmq", Shared: false},
+	{Name: "rabbit-1", Backend: "rabbitmq", Shared: false},
+	{Name: "rabbit-3", Backend: "rabbitmq", Shared: false},
+	{Name: "puffin-1", Backend: "lavinmq", Shared: false},
+	{Name: "penguin-1", Backend: "lavinmq", Shared: false},
+	{Name: "penguin-3", Backend: "lavinmq", Shared: false},
+}
+
+var regions = []api.Region{
+	{Provider: "amazon-web-services", Region: "us-east-1"},
+	{Provider: "amazon-web-services", Region: "eu-north-1"},
+	{Provider: "google-compute-engine", Region: "europe-west1"},
+	{Provider: "azure-arm", Region: "westeurope"},
+}
+
+func (s *Server) registerMetadata(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/plans", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, plans)
+	})
+	mux.HandleFunc("GET /api/regions", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, regions)
+	})
+}
+
+func findPlan(name string) (api.Plan, bool) {
+	for _, plan := range plans {
+		if plan.Name == name {
+			return plan, true
+		}
+	}
+	return api.Plan{}, false
+}
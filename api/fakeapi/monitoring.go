@@ -0,0 +1,305 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/monitoring"
+)
+
+// addDefaultAlarms: default recipient and alarms created together with the instance. Must hold
+// the lock.
+func (s *Server) addDefaultAlarms(inst *instance) {
+	recipientID := s.newID()
+	inst.recipients[recipientID] = &model.RecipientResponse{
+		ID:    recipientID,
+		Type:  "email",
+		Value: "default@example.com",
+		Name:  "Default",
+	}
+
+	recipients := []int64{recipientID}
+	threshold := func(value, time int64) (*int64, *int64) { return &value, &time }
+	for _, alarmType := range []string{"cpu", "memory", "disk", "notice"} {
+		alarm := &model.AlarmResponse{
+			ID:         s.newID(),
+			Type:       alarmType,
+			Enabled:    true,
+			Recipients: &recipients,
+		}
+		switch alarmType {
+		case "cpu", "memory":
+			alarm.ValueThreshold, alarm.TimeThreshold = threshold(90, 600)
+		case "disk":
+			alarm.ValueThreshold, alarm.TimeThreshold = threshold(5, 600)
+		}
+		inst.alarms[alarm.ID] = alarm
+	}
+}
+
+func (s *Server) registerMonitoring(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/instances/{id}/alarms", s.createAlarm)
+	mux.HandleFunc("GET /api/instances/{id}/alarms", s.listAlarms)
+	mux.HandleFunc("GET /api/instances/{id}/alarms/{alarm_id}", s.readAlarm)
+	mux.HandleFunc("PUT /api/instances/{id}/alarms/{alarm_id}", s.updateAlarm)
+	mux.HandleFunc("DELETE /api/instances/{id}/alarms/{alarm_id}", s.deleteAlarm)
+
+	mux.HandleFunc("POST /api/instances/{id}/alarms/recipients", s.createRecipient)
+	mux.HandleFunc("GET /api/instances/{id}/alarms/recipients", s.listRecipients)
+	mux.HandleFunc("GET /api/instances/{id}/alarms/recipients/{recipient_id}", s.readRecipient)
+	mux.HandleFunc("PUT /api/instances/{id}/alarms/recipients/{recipient_id}", s.updateRecipient)
+	mux.HandleFunc("DELETE /api/instances/{id}/alarms/recipients/{recipient_id}", s.deleteRecipient)
+}
+
+func alarmFromRequest(id int64, params model.AlarmRequest) *model.AlarmResponse {
+	optional := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	return &model.AlarmResponse{
+		ID:               id,
+		Type:             params.Type,
+		Enabled:          params.Enabled,
+		ReminderInterval: params.ReminderInterval,
+		ValueThreshold:   params.ValueThreshold,
+		ValueCalculation: optional(params.ValueCalculation),
+		TimeThreshold:    params.TimeThreshold,
+		VhostRegex:       optional(params.VhostRegex),
+		QueueRegex:       optional(params.QueueRegex),
+		MessageType:      optional(params.MessageType),
+		Recipients:       params.Recipients,
+	}
+}
+
+// pathID: parses an identifier path value, writes not found if invalid.
+func pathID(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		writeNotFound(w)
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) createAlarm(w http.ResponseWriter, r *http.Request) {
+	var params model.AlarmRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if params.Type == "" {
+		writeError(w, http.StatusBadRequest, "Alarm type is required")
+		return
+	}
+
+	alarm := alarmFromRequest(s.newID(), params)
+	inst.alarms[alarm.ID] = alarm
+	writeJSON(w, http.StatusCreated, alarm)
+}
+
+func (s *Server) listAlarms(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	alarms := make([]model.AlarmResponse, 0, len(inst.alarms))
+	for _, alarm := range inst.alarms {
+		alarms = append(alarms, *alarm)
+	}
+	sort.Slice(alarms, func(i, j int) bool { return alarms[i].ID < alarms[j].ID })
+	writeJSON(w, http.StatusOK, alarms)
+}
+
+func (s *Server) readAlarm(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	alarmID, ok := pathID(w, r, "alarm_id")
+	if !ok {
+		return
+	}
+	alarm, ok := inst.alarms[alarmID]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, alarm)
+}
+
+func (s *Server) updateAlarm(w http.ResponseWriter, r *http.Request) {
+	var params model.AlarmRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	alarmID, ok := pathID(w, r, "alarm_id")
+	if !ok {
+		return
+	}
+	if _, ok := inst.alarms[alarmID]; !ok {
+		writeNotFound(w)
+		return
+	}
+	inst.alarms[alarmID] = alarmFromRequest(alarmID, params)
+	writeJSON(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteAlarm(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	alarmID, ok := pathID(w, r, "alarm_id")
+	if !ok {
+		return
+	}
+	if _, ok := inst.alarms[alarmID]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(inst.alarms, alarmID)
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (s *Server) createRecipient(w http.ResponseWriter, r *http.Request) {
+	var params model.RecipientRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if params.Type == "" || params.Value == "" {
+		writeError(w, http.StatusBadRequest, "Recipient type and value are required")
+		return
+	}
+
+	recipient := &model.RecipientResponse{
+		ID:      s.newID(),
+		Type:    params.Type,
+		Value:   params.Value,
+		Name:    params.Name,
+		Options: params.Options,
+	}
+	inst.recipients[recipient.ID] = recipient
+	writeJSON(w, http.StatusCreated, recipient)
+}
+
+func (s *Server) listRecipients(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	recipients := make([]model.RecipientResponse, 0, len(inst.recipients))
+	for _, recipient := range inst.recipients {
+		recipients = append(recipients, *recipient)
+	}
+	sort.Slice(recipients, func(i, j int) bool { return recipients[i].ID < recipients[j].ID })
+	writeJSON(w, http.StatusOK, recipients)
+}
+
+func (s *Server) readRecipient(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	recipientID, ok := pathID(w, r, "recipient_id")
+	if !ok {
+		return
+	}
+	recipient, ok := inst.recipients[recipientID]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, recipient)
+}
+
+func (s *Server) updateRecipient(w http.ResponseWriter, r *http.Request) {
+	var params model.RecipientRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	recipientID, ok := pathID(w, r, "recipient_id")
+	if !ok {
+		return
+	}
+	if _, ok := inst.recipients[recipientID]; !ok {
+		writeNotFound(w)
+		return
+	}
+	inst.recipients[recipientID] = &model.RecipientResponse{
+		ID:      recipientID,
+		Type:    params.Type,
+		Value:   params.Value,
+		Name:    params.Name,
+		Options: params.Options,
+	}
+	writeJSON(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteRecipient(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	recipientID, ok := pathID(w, r, "recipient_id")
+	if !ok {
+		return
+	}
+	if _, ok := inst.recipients[recipientID]; !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(inst.recipients, recipientID)
+	writeJSON(w, http.StatusNoContent, nil)
+}
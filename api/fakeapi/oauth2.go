@@ -0,0 +1,102 @@
+package fakeapi
+
+import (
+	"maps"
+	"net/http"
+)
+
+// oauth2Configuration: the OAuth2 configuration of the instance, stored as requested.
+type oauth2Configuration struct {
+	ID     string
+	params map[string]any
+}
+
+func (oc *oauth2Configuration) response() map[string]any {
+	data := map[string]any{
+		"verify_aud":               true,
+		"oauth_disable_basic_auth": false,
+	}
+	maps.Copy(data, oc.params)
+	data["id"] = oc.ID
+	return data
+}
+
+func (s *Server) registerOAuth2Configuration(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/instances/{id}/oauth2-configurations", s.createOAuth2Configuration)
+	mux.HandleFunc("GET /api/instances/{id}/oauth2-configurations", s.readOAuth2Configuration)
+	mux.HandleFunc("PUT /api/instances/{id}/oauth2-configurations", s.updateOAuth2Configuration)
+	mux.HandleFunc("DELETE /api/instances/{id}/oauth2-configurations", s.deleteOAuth2Configuration)
+}
+
+func (s *Server) createOAuth2Configuration(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.oauth2Configuration != nil {
+		writeError(w, http.StatusBadRequest, "OAuth2 already configured")
+		return
+	}
+	inst.oauth2Configuration = &oauth2Configuration{ID: inst.hostname(), params: params}
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "oauth2", "create", "")})
+}
+
+func (s *Server) readOAuth2Configuration(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.oauth2Configuration == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, inst.oauth2Configuration.response())
+}
+
+func (s *Server) updateOAuth2Configuration(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.oauth2Configuration == nil {
+		writeNotFound(w)
+		return
+	}
+	inst.oauth2Configuration.params = params
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "oauth2", "update", "")})
+}
+
+func (s *Server) deleteOAuth2Configuration(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.oauth2Configuration == nil {
+		writeNotFound(w)
+		return
+	}
+	inst.oauth2Configuration = nil
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "oauth2", "delete", "")})
+}
@@ -0,0 +1,110 @@
+package fakeapi
+
+import (
+	"net/http"
+)
+
+// trustStore: the trust store configuration of the instance, certificates are write-only and
+// never returned.
+type trustStore struct {
+	ID              string
+	Provider        string
+	URL             any
+	RefreshInterval any
+}
+
+func (ts *trustStore) configure(params map[string]any) {
+	ts.Provider, _ = params["provider"].(string)
+	ts.URL = params["url"]
+	ts.RefreshInterval = params["refresh_interval"]
+}
+
+func (ts *trustStore) response() map[string]any {
+	return map[string]any{
+		"id":               ts.ID,
+		"provider":         ts.Provider,
+		"url":              ts.URL,
+		"refresh_interval": ts.RefreshInterval,
+	}
+}
+
+func (s *Server) registerTrustStore(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/instances/{id}/trust-store-configuration", s.createTrustStore)
+	mux.HandleFunc("GET /api/instances/{id}/trust-store-configuration", s.readTrustStore)
+	mux.HandleFunc("PUT /api/instances/{id}/trust-store-configuration", s.updateTrustStore)
+	mux.HandleFunc("DELETE /api/instances/{id}/trust-store-configuration", s.deleteTrustStore)
+}
+
+func (s *Server) createTrustStore(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.trustStore != nil {
+		writeError(w, http.StatusBadRequest, "Trust store already configured")
+		return
+	}
+	inst.trustStore = &trustStore{ID: inst.hostname()}
+	inst.trustStore.configure(params)
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "trust-store", "create", "")})
+}
+
+func (s *Server) readTrustStore(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.trustStore == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, inst.trustStore.response())
+}
+
+func (s *Server) updateTrustStore(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.trustStore == nil {
+		writeNotFound(w)
+		return
+	}
+	inst.trustStore.configure(params)
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "trust-store", "update", "")})
+}
+
+func (s *Server) deleteTrustStore(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	if inst.trustStore == nil {
+		writeNotFound(w)
+		return
+	}
+	inst.trustStore = nil
+	writeJSON(w, http.StatusOK, map[string]any{"job_id": s.newJob(inst, "trust-store", "delete", "")})
+}
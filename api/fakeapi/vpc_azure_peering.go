@@ -0,0 +1,89 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+)
+
+// azurePeering: a VNet peering of a standalone VPC, Initiated until listed Options.SettleAfter
+// times, then Connected.
+type azurePeering struct {
+	model.AzurePeeringResponse
+	pending int
+}
+
+func (s *Server) requestAzurePeering(w http.ResponseWriter, r *http.Request) {
+	var params model.AzurePeeringRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vpc(w, r)
+	if !ok {
+		return
+	}
+	if params.SubscriptionID == "" || params.ResourceGroup == "" || params.VnetName == "" {
+		writeError(w, http.StatusBadRequest, "subscription_id, resource_group and vnet_name are required")
+		return
+	}
+
+	name := fmt.Sprintf("cloudamqp-to-%s", params.VnetName)
+	v.azurePeerings = append(v.azurePeerings, &azurePeering{
+		AzurePeeringResponse: model.AzurePeeringResponse{
+			Name: name,
+			RemoteVirtualNetworkID: fmt.Sprintf(
+				"/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s",
+				params.SubscriptionID, params.ResourceGroup, params.VnetName),
+			PeeringState:      "Initiated",
+			PeeringSyncLevel:  "RemoteNotInSync",
+			ProvisioningState: "Succeeded",
+		},
+		pending: s.opts.SettleAfter,
+	})
+	writeJSON(w, http.StatusOK, model.AzurePeeringCreateResponse{Peering: name})
+}
+
+func (s *Server) listAzurePeerings(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vpc(w, r)
+	if !ok {
+		return
+	}
+	rows := make([]model.AzurePeeringResponse, len(v.azurePeerings))
+	for i, peering := range v.azurePeerings {
+		if peering.PeeringState == "Initiated" && settle(&peering.pending) {
+			peering.PeeringState = "Connected"
+			peering.PeeringSyncLevel = "FullyInSync"
+		}
+		rows[i] = peering.AzurePeeringResponse
+	}
+	writeJSON(w, http.StatusOK, model.AzurePeeringListResponse{Rows: rows})
+}
+
+func (s *Server) removeAzurePeering(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vpc(w, r)
+	if !ok {
+		return
+	}
+	name := r.PathValue("peering")
+	i := slices.IndexFunc(v.azurePeerings, func(peering *azurePeering) bool {
+		return peering.Name == name
+	})
+	if i == -1 {
+		writeNotFound(w)
+		return
+	}
+	v.azurePeerings = slices.Delete(v.azurePeerings, i, i+1)
+	writeJSON(w, http.StatusNoContent, nil)
+}
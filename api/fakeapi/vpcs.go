@@ -0,0 +1,137 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+)
+
+type vpc struct {
+	model.VpcResponse
+	pending       int
+	azurePeerings []*azurePeering
+}
+
+func (s *Server) registerVpcs(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/vpcs", s.createVpc)
+	mux.HandleFunc("GET /api/vpcs/{vpc_id}", s.readVpc)
+	mux.HandleFunc("PUT /api/vpcs/{vpc_id}", s.updateVpc)
+	mux.HandleFunc("DELETE /api/vpcs/{vpc_id}", s.deleteVpc)
+	mux.HandleFunc("GET /api/vpcs/{vpc_id}/vpc-peering/info", s.vpcInfo)
+	mux.HandleFunc("POST /api/vpcs/{vpc_id}/vpc-peering", s.requestAzurePeering)
+	mux.HandleFunc("GET /api/vpcs/{vpc_id}/vpc-peering", s.listAzurePeerings)
+	mux.HandleFunc("DELETE /api/vpcs/{vpc_id}/vpc-peering/{peering}", s.removeAzurePeering)
+}
+
+// vpc: looks up the VPC from the path, writes not found if missing. Must hold the lock.
+func (s *Server) vpc(w http.ResponseWriter, r *http.Request) (*vpc, bool) {
+	vpcID, ok := pathID(w, r, "vpc_id")
+	if !ok {
+		return nil, false
+	}
+	v, ok := s.vpcs[vpcID]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+	return v, true
+}
+
+func (s *Server) createVpc(w http.ResponseWriter, r *http.Request) {
+	var params model.VpcRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if params.Name == "" || params.Region == "" || params.Subnet == "" {
+		writeError(w, http.StatusBadRequest, "name, region and subnet are required")
+		return
+	}
+
+	v := &vpc{
+		VpcResponse: model.VpcResponse{
+			ID:     int(s.newID()),
+			Name:   params.Name,
+			Region: params.Region,
+			Subnet: params.Subnet,
+			Tags:   params.Tags,
+		},
+		pending: s.opts.SettleAfter,
+	}
+	s.vpcs[int64(v.ID)] = v
+	writeJSON(w, http.StatusOK, v.VpcResponse)
+}
+
+func (s *Server) readVpc(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vpc(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, v.VpcResponse)
+}
+
+func (s *Server) updateVpc(w http.ResponseWriter, r *http.Request) {
+	var params model.VpcRequest
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vpc(w, r)
+	if !ok {
+		return
+	}
+	if params.Name != "" {
+		v.Name = params.Name
+	}
+	if params.Tags != nil {
+		v.Tags = params.Tags
+	}
+	writeJSON(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteVpc(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vpc(w, r)
+	if !ok {
+		return
+	}
+	for _, inst := range s.instances {
+		if inst.VpcID == int64(v.ID) {
+			writeError(w, http.StatusBadRequest, "VPC is in use by an instance")
+			return
+		}
+	}
+	delete(s.vpcs, int64(v.ID))
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+// vpcInfo: responds with bad request until the VPC is available.
+func (s *Server) vpcInfo(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.vpc(w, r)
+	if !ok {
+		return
+	}
+	if !settle(&v.pending) {
+		writeError(w, http.StatusBadRequest, "VPC currently unavailable")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"name":   fmt.Sprintf("vpc-%08x", v.ID),
+		"subnet": v.Subnet,
+	})
+}
@@ -0,0 +1,132 @@
+package fakeapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// webhook: the configuration is kept as sent.
+type webhook struct {
+	ID     int64
+	Config map[string]any
+}
+
+func (wh *webhook) configure(params map[string]any) {
+	delete(params, "webhook_id")
+	wh.Config = params
+}
+
+func (wh *webhook) response() map[string]any {
+	data := map[string]any{"id": wh.ID, "last_status": nil}
+	for k, v := range wh.Config {
+		data[k] = v
+	}
+	return data
+}
+
+func (s *Server) registerWebhooks(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/instances/{id}/webhooks", s.listWebhooks)
+	mux.HandleFunc("POST /api/instances/{id}/webhooks", s.createWebhook)
+	mux.HandleFunc("GET /api/instances/{id}/webhooks/{webhook_id}", s.readWebhook)
+	mux.HandleFunc("PUT /api/instances/{id}/webhooks/{webhook_id}", s.updateWebhook)
+	mux.HandleFunc("DELETE /api/instances/{id}/webhooks/{webhook_id}", s.deleteWebhook)
+}
+
+// webhook: looks up a single webhook from the path. Must hold the lock.
+func (s *Server) webhook(w http.ResponseWriter, r *http.Request) (*instance, *webhook, bool) {
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	id, err := strconv.ParseInt(r.PathValue("webhook_id"), 10, 64)
+	if err != nil {
+		writeNotFound(w)
+		return nil, nil, false
+	}
+	wh, ok := inst.webhooks[id]
+	if !ok {
+		writeNotFound(w)
+		return nil, nil, false
+	}
+	return inst, wh, true
+}
+
+func (s *Server) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	ids := make([]int64, 0, len(inst.webhooks))
+	for id := range inst.webhooks {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	rows := make([]map[string]any, len(ids))
+	for i, id := range ids {
+		rows[i] = inst.webhooks[id].response()
+	}
+	writeJSON(w, http.StatusOK, rows)
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, ok := s.instance(w, r)
+	if !ok {
+		return
+	}
+	wh := &webhook{ID: s.newID()}
+	wh.configure(params)
+	inst.webhooks[wh.ID] = wh
+	writeJSON(w, http.StatusCreated, map[string]any{"id": wh.ID})
+}
+
+func (s *Server) readWebhook(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, wh, ok := s.webhook(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, wh.response())
+}
+
+func (s *Server) updateWebhook(w http.ResponseWriter, r *http.Request) {
+	var params map[string]any
+	if !decodeJSON(w, r, &params) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, wh, ok := s.webhook(w, r)
+	if !ok {
+		return
+	}
+	wh.configure(params)
+	writeJSON(w, http.StatusOK, nil)
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inst, wh, ok := s.webhook(w, r)
+	if !ok {
+		return
+	}
+	delete(inst.webhooks, wh.ID)
+	writeJSON(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/models/job"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ListFeatureFlags: list feature flags and their state from an instance, returns nil if the
+// instance is not found.
+func (api *API) ListFeatureFlags(ctx context.Context, instanceID int64, sleep time.Duration) (
+	[]instance.FeatureFlagResponse, error) {
+
+	var (
+		data       []instance.FeatureFlagResponse
+		failed     map[string]any
+		statusCode int
+		path       = fmt.Sprintf("/api/instances/%d/feature-flags", instanceID)
+	)
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s", path))
+	err := api.callWithRetry(ctx, api.sling.New().Get(path), retryRequest{
+		functionName: "ListFeatureFlags",
+		resourceName: "FeatureFlags",
+		attempt:      1,
+		sleep:        sleep,
+		data:         &data,
+		failed:       &failed,
+		statusCode:   &statusCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle resource drift
+	if statusCode == 404 || statusCode == 410 {
+		return nil, nil
+	}
+	if data == nil {
+		data = []instance.FeatureFlagResponse{}
+	}
+
+	return data, nil
+}
+
+// EnableFeatureFlags: enable a set of feature flags, or all stable feature flags, on an instance.
+func (api *API) EnableFeatureFlags(ctx context.Context, instanceID int64,
+	params instance.FeatureFlagsRequest) (*job.JobCreationResponse, error) {
+
+	var (
+		data   *job.JobCreationResponse
+		failed map[string]any
+		path   = fmt.Sprintf("/api/instances/%d/feature-flags", instanceID)
+	)
+
+	tflog.Debug(ctx, fmt.Sprintf("method=POST path=%s params=%+v", path, params))
+	err := api.callWithRetry(ctx, api.sling.New().Post(path).BodyJSON(params), retryRequest{
+		functionName: "EnableFeatureFlags",
+		resourceName: "FeatureFlags",
+		attempt:      1,
+		sleep:        5 * time.Second,
+		data:         &data,
+		failed:       &failed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("no data received from API")
+	}
+
+	return data, nil
+}
@@ -35,6 +35,31 @@ func (api *API) CreateIntegrationLog(ctx context.Context, instanceID int64, intN
 	return id, nil
 }
 
+// ListIntegrationLogs - list all log integrations for an instance
+func (api *API) ListIntegrationLogs(ctx context.Context, instanceID int64) ([]model.LogResponse, error) {
+	var (
+		data   []model.LogResponse
+		failed map[string]any
+		path   = fmt.Sprintf("/api/instances/%d/integrations/logs", instanceID)
+	)
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s ", path))
+	err := api.callWithRetry(ctx, api.sling.New().Get(path), retryRequest{
+		functionName: "ListIntegrationLogs",
+		resourceName: "IntegrationLog",
+		attempt:      1,
+		sleep:        5 * time.Second,
+		data:         &data,
+		failed:       &failed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s data=%+v ", path, data))
+	return data, nil
+}
+
 // ReadIntegrationLog - retrieves a specific integration log for an instance
 func (api *API) ReadIntegrationLog(ctx context.Context, instanceID int64, logID string) (*model.LogResponse, error) {
 
@@ -35,6 +35,31 @@ func (api *API) CreateIntegrationMetric(ctx context.Context, instanceID int64, i
 	return id, nil
 }
 
+// ListIntegrationMetrics - list all metric integrations for an instance
+func (api *API) ListIntegrationMetrics(ctx context.Context, instanceID int64) ([]model.MetricResponse, error) {
+	var (
+		data   []model.MetricResponse
+		failed map[string]any
+		path   = fmt.Sprintf("/api/instances/%d/integrations/metrics", instanceID)
+	)
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s ", path))
+	err := api.callWithRetry(ctx, api.sling.New().Get(path), retryRequest{
+		functionName: "ListIntegrationMetrics",
+		resourceName: "IntegrationMetric",
+		attempt:      1,
+		sleep:        5 * time.Second,
+		data:         &data,
+		failed:       &failed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s data=%+v ", path, data))
+	return data, nil
+}
+
 // ReadIntegrationMetric - retrieves a specific integration metric for an instance
 func (api *API) ReadIntegrationMetric(ctx context.Context, instanceID int64, metricID string) (*model.MetricResponse, error) {
 
@@ -0,0 +1,19 @@
+package configuration
+
+type LavinMqConfigRequest struct {
+	SegmentSize             *int64 `json:"main.segment_size,omitempty"`
+	MaxMessageSize          *int64 `json:"main.max_message_size,omitempty"`
+	DefaultConsumerPrefetch *int64 `json:"main.default_consumer_prefetch,omitempty"`
+	Heartbeat               *int64 `json:"amqp.heartbeat,omitempty"`
+	ChannelMax              *int64 `json:"amqp.channel_max,omitempty"`
+	ConnectionMax           *int64 `json:"amqp.connection_max,omitempty"`
+}
+
+type LavinMqConfigResponse struct {
+	SegmentSize             int64 `json:"main.segment_size"`
+	MaxMessageSize          int64 `json:"main.max_message_size"`
+	DefaultConsumerPrefetch int64 `json:"main.default_consumer_prefetch"`
+	Heartbeat               int64 `json:"amqp.heartbeat"`
+	ChannelMax              int64 `json:"amqp.channel_max"`
+	ConnectionMax           int64 `json:"amqp.connection_max"`
+}
@@ -23,6 +23,20 @@ type RabbitMqConfigRequest struct {
 	SSLOptionsFailIfNoPeerCert            *bool                         `json:"rabbit.ssl_options.fail_if_no_peer_cert,omitempty"`
 	SSLOptionsVerify                      *string                       `json:"rabbit.ssl_options.verify,omitempty"`
 	MessageInterceptorsTimestampOverwrite *string                       `json:"message_interceptors.incoming.set_header_timestamp.overwrite,omitempty"`
+	// Queue defaults
+	DefaultQueueType                         *string `json:"rabbit.default_queue_type,omitempty"`
+	QuorumClusterSize                        *int64  `json:"rabbit.quorum_cluster_size,omitempty"`
+	QuorumCommandsSoftLimit                  *int64  `json:"rabbit.quorum_commands_soft_limit,omitempty"`
+	QuorumContinuousMembershipReconciliation *bool   `json:"quorum_queue.continuous_membership_reconciliation.enabled,omitempty"`
+	// Stream settings
+	StreamFrameMax  *int64 `json:"rabbitmq_stream.frame_max,omitempty"`
+	StreamHeartbeat *int64 `json:"rabbitmq_stream.heartbeat,omitempty"`
+	// Resource limits
+	VmMemoryHighWatermarkPagingRatio *float64 `json:"rabbit.vm_memory_high_watermark_paging_ratio,omitempty"`
+	DiskFreeLimit                    *int64   `json:"rabbit.disk_free_limit,omitempty"`
+	// Log levels
+	LogDefaultLevel    *string `json:"rabbit.log.default.level,omitempty"`
+	LogConnectionLevel *string `json:"rabbit.log.connection.level,omitempty"`
 }
 
 type RabbitMqConfigResponse struct {
@@ -43,6 +57,17 @@ type RabbitMqConfigResponse struct {
 	SSLOptionsFailIfNoPeerCert            BooleanString                 `json:"rabbit.ssl_options.fail_if_no_peer_cert"`
 	SSLOptionsVerify                      string                        `json:"rabbit.ssl_options.verify"`
 	MessageInterceptorsTimestampOverwrite string                        `json:"message_interceptors.incoming.set_header_timestamp.overwrite"`
+	// Keys not supported by the RabbitMQ version of the instance are omitted
+	DefaultQueueType                         *string        `json:"rabbit.default_queue_type,omitempty"`
+	QuorumClusterSize                        *int64         `json:"rabbit.quorum_cluster_size,omitempty"`
+	QuorumCommandsSoftLimit                  *int64         `json:"rabbit.quorum_commands_soft_limit,omitempty"`
+	QuorumContinuousMembershipReconciliation *BooleanString `json:"quorum_queue.continuous_membership_reconciliation.enabled,omitempty"`
+	StreamFrameMax                           *int64         `json:"rabbitmq_stream.frame_max,omitempty"`
+	StreamHeartbeat                          *int64         `json:"rabbitmq_stream.heartbeat,omitempty"`
+	VmMemoryHighWatermarkPagingRatio         *float64       `json:"rabbit.vm_memory_high_watermark_paging_ratio,omitempty"`
+	DiskFreeLimit                            *int64         `json:"rabbit.disk_free_limit,omitempty"`
+	LogDefaultLevel                          *string        `json:"rabbit.log.default.level,omitempty"`
+	LogConnectionLevel                       *string        `json:"rabbit.log.connection.level,omitempty"`
 }
 
 // Custom type for ConnectionMax
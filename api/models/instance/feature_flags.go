@@ -0,0 +1,13 @@
+package instance
+
+type FeatureFlagResponse struct {
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	Stability   string `json:"stability"`
+	Description string `json:"desc,omitempty"`
+}
+
+type FeatureFlagsRequest struct {
+	Enable          []string `json:"enable,omitempty"`
+	EnableAllStable bool     `json:"enable_all_stable,omitempty"`
+}
@@ -12,6 +12,8 @@ type LogRequest struct {
 	Endpoint          string `json:"endpoint,omitempty"`
 	Host              string `json:"host,omitempty"`
 	HostPort          string `json:"host_port,omitempty"`
+	Index             string `json:"index,omitempty"`
+	Password          string `json:"password,omitempty"`
 	PrivateKey        string `json:"private_key,omitempty"`
 	PrivateKeyID      string `json:"private_key_id,omitempty"`
 	ProjectID         string `json:"project_id,omitempty"`
@@ -25,6 +27,11 @@ type LogRequest struct {
 	TenantID          string `json:"tenant_id,omitempty"`
 	Token             string `json:"token,omitempty"`
 	URL               string `json:"url,omitempty"`
+	Username          string `json:"username,omitempty"`
+	// OpenTelemetry integration
+	Headers            *map[string]string `json:"headers,omitempty"`
+	Protocol           string             `json:"protocol,omitempty"`
+	ResourceAttributes *map[string]string `json:"resource_attributes,omitempty"`
 }
 
 type LogResponse struct {
@@ -45,6 +52,8 @@ type LogConfigResponse struct {
 	Endpoint          *string `json:"endpoint,omitempty"`
 	Host              *string `json:"host,omitempty"`
 	HostPort          *string `json:"host_port,omitempty"`
+	Index             *string `json:"index,omitempty"`
+	Password          *string `json:"password,omitempty"`
 	PrivateKey        *string `json:"private_key,omitempty"`
 	PrivateKeyID      *string `json:"private_key_id,omitempty"`
 	ProjectID         *string `json:"project_id,omitempty"`
@@ -58,4 +67,9 @@ type LogConfigResponse struct {
 	TenantID          *string `json:"tenant_id,omitempty"`
 	Token             *string `json:"token,omitempty"`
 	URL               *string `json:"url,omitempty"`
+	Username          *string `json:"username,omitempty"`
+	// OpenTelemetry integration
+	Headers            map[string]string `json:"headers,omitempty"`
+	Protocol           *string           `json:"protocol,omitempty"`
+	ResourceAttributes map[string]string `json:"resource_attributes,omitempty"`
 }
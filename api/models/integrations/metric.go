@@ -19,12 +19,25 @@ type MetricRequest struct {
 	Tags            string `json:"tags,omitempty"`
 	Token           string `json:"token,omitempty"`
 	VhostRegex      string `json:"vhost_regex,omitempty"`
+	// Prometheus based integrations
+	AccessToken                    string   `json:"access_token,omitempty"`
+	ConnectionString               string   `json:"connection_string,omitempty"`
+	Endpoint                       string   `json:"endpoint,omitempty"`
+	EnvironmentID                  string   `json:"environment_id,omitempty"`
+	MetricsFilter                  []string `json:"metrics_filter,omitempty"`
+	RabbitMQDashboardMetricsFormat string   `json:"rabbitmq_dashboard_metrics_format,omitempty"`
+	Type                           string   `json:"type,omitempty"`
+	// OpenTelemetry integration
+	Headers            *map[string]string `json:"headers,omitempty"`
+	Protocol           string             `json:"protocol,omitempty"`
+	ResourceAttributes *map[string]string `json:"resource_attributes,omitempty"`
 }
 
 type MetricResponse struct {
-	ID     int64                 `json:"id"`
-	Type   string                `json:"type"`
-	Config *MetricConfigResponse `json:"config"`
+	ID            int64                 `json:"id"`
+	Type          string                `json:"type"`
+	Config        *MetricConfigResponse `json:"config"`
+	MetricsFilter []string              `json:"metrics_filter,omitempty"`
 }
 
 type MetricConfigResponse struct {
@@ -46,4 +59,14 @@ type MetricConfigResponse struct {
 	Tags            *string `json:"tags,omitempty"`
 	Token           *string `json:"token,omitempty"`
 	VhostRegex      *string `json:"vhost_regex,omitempty"`
+	// Prometheus based integrations
+	AccessToken                    *string `json:"access_token,omitempty"`
+	ConnectionString               *string `json:"connection_string,omitempty"`
+	Endpoint                       *string `json:"endpoint,omitempty"`
+	EnvironmentID                  *string `json:"environment_id,omitempty"`
+	RabbitMQDashboardMetricsFormat *string `json:"rabbitmq_dashboard_metrics_format,omitempty"`
+	// OpenTelemetry integration
+	Headers            map[string]string `json:"headers,omitempty"`
+	Protocol           *string           `json:"protocol,omitempty"`
+	ResourceAttributes map[string]string `json:"resource_attributes,omitempty"`
 }
@@ -16,10 +16,10 @@ type WebhookUpdateRequest struct {
 }
 
 type WebhookResponse struct {
-	ID          int64  `json:"id"`
-	Concurrency int64  `json:"concurrency"`
-	WebhookURI  string `json:"webhook_uri"`
-	Vhost       string `json:"vhost"`
-	Queue       string `json:"queue"`
-	LastStatus  string `json:"last_status,omitempty"`
+	ID          int64   `json:"id"`
+	Concurrency int64   `json:"concurrency"`
+	WebhookURI  string  `json:"webhook_uri"`
+	Vhost       string  `json:"vhost"`
+	Queue       string  `json:"queue"`
+	LastStatus  *string `json:"last_status,omitempty"`
 }
@@ -0,0 +1,89 @@
+package network
+
+// Peering: provider independent view of a VPC peering, used to expose consistent status attributes
+// for AWS, GCP and Azure peerings.
+type Peering struct {
+	ID            string
+	Status        string
+	StatusMessage string
+}
+
+type PeeringStatusResponse struct {
+	Status string `json:"status"`
+}
+
+type AwsPeeringStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type AwsPeeringResponse struct {
+	VpcPeeringConnectionID string           `json:"vpc_peering_connection_id"`
+	Status                 AwsPeeringStatus `json:"status"`
+}
+
+func (p AwsPeeringResponse) Peering() Peering {
+	return Peering{
+		ID:            p.VpcPeeringConnectionID,
+		Status:        p.Status.Code,
+		StatusMessage: p.Status.Message,
+	}
+}
+
+type GcpPeeringRequest struct {
+	PeerNetworkUri string `json:"peer_network_uri"`
+}
+
+type GcpPeeringCreateResponse struct {
+	Peering string `json:"peering"`
+}
+
+type GcpPeeringListResponse struct {
+	Rows []GcpPeeringResponse `json:"rows"`
+}
+
+type GcpPeeringResponse struct {
+	Name             string `json:"name"`
+	Network          string `json:"network"`
+	State            string `json:"state"`
+	StateDetails     string `json:"stateDetails"`
+	AutoCreateRoutes bool   `json:"autoCreateRoutes"`
+}
+
+func (p GcpPeeringResponse) Peering() Peering {
+	return Peering{
+		ID:            p.Name,
+		Status:        p.State,
+		StatusMessage: p.StateDetails,
+	}
+}
+
+type AzurePeeringRequest struct {
+	SubscriptionID string `json:"subscription_id"`
+	ResourceGroup  string `json:"resource_group"`
+	VnetName       string `json:"vnet_name"`
+}
+
+type AzurePeeringCreateResponse struct {
+	Peering string `json:"peering"`
+}
+
+type AzurePeeringListResponse struct {
+	Rows []AzurePeeringResponse `json:"rows"`
+}
+
+type AzurePeeringResponse struct {
+	Name                   string `json:"name"`
+	RemoteVirtualNetworkID string `json:"remote_virtual_network_id"`
+	PeeringState           string `json:"peering_state"`
+	PeeringSyncLevel       string `json:"peering_sync_level"`
+	ProvisioningState      string `json:"provisioning_state"`
+}
+
+func (p AzurePeeringResponse) Peering() Peering {
+	return Peering{
+		ID:            p.Name,
+		Status:        p.PeeringState,
+		StatusMessage: p.PeeringSyncLevel,
+	}
+}
@@ -0,0 +1,20 @@
+package network
+
+// VpcConnectRequest: only the allowlist matching the instance platform is set, a pointer to an
+// empty list clears the allowlist.
+type VpcConnectRequest struct {
+	AllowedPrincipals     *[]string `json:"allowed_principals,omitempty"`
+	ApprovedSubscriptions *[]string `json:"approved_subscriptions,omitempty"`
+	AllowedProjects       *[]string `json:"allowed_projects,omitempty"`
+}
+
+type VpcConnectResponse struct {
+	Status                string    `json:"status"`
+	ServiceName           string    `json:"service_name"`
+	Alias                 string    `json:"alias"`
+	ServerName            string    `json:"server_name"`
+	AllowedPrincipals     *[]string `json:"allowed_principals"`
+	ApprovedSubscriptions *[]string `json:"approved_subscriptions"`
+	AllowedProjects       *[]string `json:"allowed_projects"`
+	ActiveZones           []string  `json:"active_zones"`
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// waitForAzurePeeringStatus: waits for the VNet peering state to be Connected or until timed out
+func (api *API) waitForAzurePeeringStatus(ctx context.Context, instanceID int64, vpcID, peerID string,
+	attempt, sleep, timeout int) error {
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	tflog.Debug(ctx, "waiting for VNet peering status")
+	for {
+		if ctxTimeout.Err() != nil {
+			return fmt.Errorf("timeout reached after %d seconds, while waiting on VNet peering status", timeout)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Checking Azure VNet peering status, attempt=%d", attempt))
+		peerings, err := api.ListVpcAzurePeerings(ctxTimeout, instanceID, vpcID, sleep, timeout)
+		if err != nil {
+			return err
+		}
+
+		// Check the rows for the matching peerID and Connected state
+		for _, peering := range peerings {
+			if peering.Name != peerID {
+				continue
+			}
+			switch peering.PeeringState {
+			case "Connected":
+				return nil
+			case "Disconnected":
+				return fmt.Errorf("VNet peering %s disconnected, the remote peering needs to be recreated", peerID)
+			}
+		}
+
+		// State is not Connected yet, sleep and retry
+		tflog.Debug(ctx, fmt.Sprintf("waiting for state set to Connected, attempt=%d", attempt))
+		attempt++
+		select {
+		case <-ctxTimeout.Done():
+			return fmt.Errorf("timeout reached after %d seconds, while waiting on VNet peering status", timeout)
+		case <-time.After(time.Duration(sleep) * time.Second):
+			continue
+		}
+	}
+}
+
+// RequestVpcAzurePeering: requests a VNet peering from an instance or a standalone VPC, returns the
+// peering name.
+func (api *API) RequestVpcAzurePeering(ctx context.Context, instanceID int64, vpcID string,
+	params model.AzurePeeringRequest, waitOnStatus bool, sleep, timeout int) (string, error) {
+
+	var (
+		data   model.AzurePeeringCreateResponse
+		failed map[string]any
+		path   = vpcPeeringPath(instanceID, vpcID)
+	)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("method=POST path=%s wait_on_status=%t sleep=%d timeout=%d params=%+v",
+		path, waitOnStatus, sleep, timeout, params))
+	err := api.callWithRetry(ctxTimeout, api.sling.New().Post(path).BodyJSON(params), retryRequest{
+		functionName:    "RequestVpcAzurePeering",
+		resourceName:    "VPC Azure Peering",
+		attempt:         1,
+		sleep:           time.Duration(sleep) * time.Second,
+		data:            &data,
+		failed:          &failed,
+		customRetryCode: 400,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if data.Peering == "" {
+		return "", fmt.Errorf("no peering received from API")
+	}
+
+	if waitOnStatus {
+		tflog.Debug(ctx, "waiting for connected state")
+		err = api.waitForAzurePeeringStatus(ctx, instanceID, vpcID, data.Peering, 1, sleep, timeout)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return data.Peering, nil
+}
+
+// ListVpcAzurePeerings: lists the VNet peerings from an instance or a standalone VPC.
+func (api *API) ListVpcAzurePeerings(ctx context.Context, instanceID int64, vpcID string, sleep,
+	timeout int) ([]model.AzurePeeringResponse, error) {
+
+	var (
+		data   model.AzurePeeringListResponse
+		failed map[string]any
+		path   = vpcPeeringPath(instanceID, vpcID)
+	)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s sleep=%d timeout=%d", path, sleep, timeout))
+	err := api.callWithRetry(ctxTimeout, api.sling.New().Get(path), retryRequest{
+		functionName:    "ListVpcAzurePeerings",
+		resourceName:    "VPC Azure Peering",
+		attempt:         1,
+		sleep:           time.Duration(sleep) * time.Second,
+		data:            &data,
+		failed:          &failed,
+		customRetryCode: 400,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Rows, nil
+}
+
+// ReadVpcAzurePeering: reads a VNet peering by its name, returns nil if not found.
+func (api *API) ReadVpcAzurePeering(ctx context.Context, instanceID int64, vpcID, peerID string,
+	sleep, timeout int) (*model.AzurePeeringResponse, error) {
+
+	peerings, err := api.ListVpcAzurePeerings(ctx, instanceID, vpcID, sleep, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, peering := range peerings {
+		if peering.Name == peerID {
+			return &peering, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// RemoveVpcAzurePeering: removes a VNet peering from an instance or a standalone VPC.
+func (api *API) RemoveVpcAzurePeering(ctx context.Context, instanceID int64, vpcID, peerID string,
+	sleep, timeout int) error {
+
+	var (
+		failed map[string]any
+		path   = fmt.Sprintf("%s/%s", vpcPeeringPath(instanceID, vpcID), peerID)
+	)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("method=DELETE path=%s sleep=%d timeout=%d", path, sleep, timeout))
+	return api.callWithRetry(ctxTimeout, api.sling.New().Delete(path), retryRequest{
+		functionName:    "RemoveVpcAzurePeering",
+		resourceName:    "VPC Azure Peering",
+		attempt:         1,
+		sleep:           time.Duration(sleep) * time.Second,
+		data:            nil,
+		failed:          &failed,
+		customRetryCode: 400,
+	})
+}
@@ -5,25 +5,26 @@ import (
 	"fmt"
 	"time"
 
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // EnableVpcConnect: Enable VPC Connect and wait until finished.
 // Need to enable VPC for an instance, if no standalone VPC used.
 // Wait until finished with configureable sleep and timeout.
-func (api *API) EnableVpcConnect(ctx context.Context, instanceID int,
-	params map[string][]any, sleep, timeout int) error {
+func (api *API) EnableVpcConnect(ctx context.Context, instanceID int64,
+	params model.VpcConnectRequest, sleep, timeout int) error {
 
 	var (
 		failed map[string]any
 		path   = fmt.Sprintf("/api/instances/%d/vpc-connect", instanceID)
 	)
 
-	if err := api.EnableVPC(ctx, instanceID); err != nil {
+	if err := api.EnableVPC(ctx, int(instanceID)); err != nil {
 		return err
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("method=POST path=%s sleep=%d timeout=%d params=%v", path, sleep,
+	tflog.Debug(ctx, fmt.Sprintf("method=POST path=%s sleep=%d timeout=%d params=%+v", path, sleep,
 		timeout, params))
 	err := api.callWithRetry(ctx, api.sling.New().Post(path).BodyJSON(params), retryRequest{
 		functionName: "EnableVpcConnect",
@@ -41,9 +42,9 @@ func (api *API) EnableVpcConnect(ctx context.Context, instanceID int,
 }
 
 // ReadVpcConnect: Reads VPC Connect information
-func (api *API) ReadVpcConnect(ctx context.Context, instanceID int) (map[string]any, error) {
+func (api *API) ReadVpcConnect(ctx context.Context, instanceID int64) (*model.VpcConnectResponse, error) {
 	var (
-		data   map[string]any
+		data   model.VpcConnectResponse
 		failed map[string]any
 		path   = fmt.Sprintf("/api/instances/%d/vpc-connect", instanceID)
 	)
@@ -61,23 +62,24 @@ func (api *API) ReadVpcConnect(ctx context.Context, instanceID int) (map[string]
 		return nil, err
 	}
 
-	if len(data) == 0 {
+	// Handle resource drift
+	if data.Status == "" {
 		return nil, nil
 	}
 
-	return data, nil
+	return &data, nil
 }
 
 // UpdateVpcConnect: Update allowlist for the VPC Connect
-func (api *API) UpdateVpcConnect(ctx context.Context, instanceID int,
-	params map[string][]any) error {
+func (api *API) UpdateVpcConnect(ctx context.Context, instanceID int64,
+	params model.VpcConnectRequest) error {
 
 	var (
 		failed map[string]any
 		path   = fmt.Sprintf("/api/instances/%d/vpc-connect", instanceID)
 	)
 
-	tflog.Debug(ctx, fmt.Sprintf("method=PUT path=%s params=%v", path, params))
+	tflog.Debug(ctx, fmt.Sprintf("method=PUT path=%s params=%+v", path, params))
 	err := api.callWithRetry(ctx, api.sling.New().Put(path).BodyJSON(params), retryRequest{
 		functionName: "UpdateVpcConnect",
 		resourceName: "VPC Connect",
@@ -94,7 +96,7 @@ func (api *API) UpdateVpcConnect(ctx context.Context, instanceID int,
 }
 
 // DisableVpcConnect: Disable the VPC Connect feature
-func (api *API) DisableVpcConnect(ctx context.Context, instanceID int) error {
+func (api *API) DisableVpcConnect(ctx context.Context, instanceID int64) error {
 	var (
 		failed map[string]any
 		path   = fmt.Sprintf("/api/instances/%d/vpc-connect", instanceID)
@@ -117,7 +119,9 @@ func (api *API) DisableVpcConnect(ctx context.Context, instanceID int) error {
 }
 
 // waitForEnableVpcConnectWithRetry: Wait until status change from pending to enable
-func (api *API) waitForEnableVpcConnectWithRetry(ctx context.Context, instanceID, attempt, sleep, timeout int) error {
+func (api *API) waitForEnableVpcConnectWithRetry(ctx context.Context, instanceID int64, attempt, sleep,
+	timeout int) error {
+
 	path := fmt.Sprintf("/api/instances/%d/vpc-connect", instanceID)
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
 	defer cancel()
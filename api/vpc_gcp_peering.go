@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // waitForGcpPeeringStatus: waits for the VPC peering status to be ACTIVE or until timed out
-func (api *API) waitForGcpPeeringStatus(ctx context.Context, path, peerID string,
+func (api *API) waitForGcpPeeringStatus(ctx context.Context, instanceID int64, vpcID, peerID string,
 	attempt, sleep, timeout int) error {
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
@@ -21,42 +22,16 @@ func (api *API) waitForGcpPeeringStatus(ctx context.Context, path, peerID string
 			return fmt.Errorf("timeout reached after %d seconds, while waiting on VPC peering status", timeout)
 		}
 
-		var (
-			data   map[string]any
-			failed map[string]any
-		)
-
 		tflog.Debug(ctx, fmt.Sprintf("Checking GCP VPC peering status, attempt=%d", attempt))
-		err := api.callWithRetry(ctxTimeout, api.sling.New().Get(path), retryRequest{
-			functionName: "waitForGcpPeeringStatus",
-			resourceName: "VPC GCP Peering",
-			attempt:      attempt,
-			sleep:        time.Duration(sleep) * time.Second,
-			data:         &data,
-			failed:       &failed,
-		})
+		peerings, err := api.ListVpcGcpPeerings(ctxTimeout, instanceID, vpcID, sleep, timeout)
 		if err != nil {
 			return err
 		}
 
 		// Check the rows for the matching peerID and ACTIVE state
-		rows, ok := data["rows"].([]any)
-		if !ok {
-			return fmt.Errorf("rows field missing or invalid in response")
-		}
-
-		if len(rows) > 0 {
-			for _, row := range rows {
-				tempRow, ok := row.(map[string]any)
-				if !ok {
-					continue
-				}
-				if tempRow["name"] != peerID {
-					continue
-				}
-				if tempRow["state"] == "ACTIVE" {
-					return nil
-				}
+		for _, peering := range peerings {
+			if peering.Name == peerID && peering.State == "ACTIVE" {
+				return nil
 			}
 		}
 
@@ -72,21 +47,22 @@ func (api *API) waitForGcpPeeringStatus(ctx context.Context, path, peerID string
 	}
 }
 
-// RequestVpcGcpPeering: requests a VPC peering from an instance.
-func (api *API) RequestVpcGcpPeering(ctx context.Context, instanceID int, params map[string]any,
-	waitOnStatus bool, sleep, timeout int) (map[string]any, error) {
+// RequestVpcGcpPeering: requests a VPC peering from an instance or a standalone VPC, returns the
+// peering name.
+func (api *API) RequestVpcGcpPeering(ctx context.Context, instanceID int64, vpcID string,
+	params model.GcpPeeringRequest, waitOnStatus bool, sleep, timeout int) (string, error) {
 
 	var (
-		data   map[string]any
+		data   model.GcpPeeringCreateResponse
 		failed map[string]any
-		path   = fmt.Sprintf("api/instances/%d/vpc-peering", instanceID)
+		path   = vpcPeeringPath(instanceID, vpcID)
 	)
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
 	defer cancel()
 
-	tflog.Debug(ctx, fmt.Sprintf("method=POST path=%s wait_on_status=%t sleep=%d timeout=%d",
-		path, waitOnStatus, sleep, timeout), params)
+	tflog.Debug(ctx, fmt.Sprintf("method=POST path=%s wait_on_status=%t sleep=%d timeout=%d params=%+v",
+		path, waitOnStatus, sleep, timeout, params))
 	err := api.callWithRetry(ctxTimeout, api.sling.New().Post(path).BodyJSON(params), retryRequest{
 		functionName:    "RequestVpcGcpPeering",
 		resourceName:    "VPC GCP Peering",
@@ -97,28 +73,32 @@ func (api *API) RequestVpcGcpPeering(ctx context.Context, instanceID int, params
 		customRetryCode: 400,
 	})
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+
+	if data.Peering == "" {
+		return "", fmt.Errorf("no peering received from API")
 	}
 
 	if waitOnStatus {
 		tflog.Debug(ctx, "waiting for active state")
-		err = api.waitForGcpPeeringStatus(ctx, path, data["peering"].(string), 1, sleep, timeout)
+		err = api.waitForGcpPeeringStatus(ctx, instanceID, vpcID, data.Peering, 1, sleep, timeout)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 	}
 
-	return data, nil
+	return data.Peering, nil
 }
 
-// ReadVpcGcpPeering: reads the VPC peering from the API
-func (api *API) ReadVpcGcpPeering(ctx context.Context, instanceID, sleep, timeout int) (
-	map[string]any, error) {
+// ListVpcGcpPeerings: lists the VPC peerings from an instance or a standalone VPC.
+func (api *API) ListVpcGcpPeerings(ctx context.Context, instanceID int64, vpcID string, sleep,
+	timeout int) ([]model.GcpPeeringResponse, error) {
 
 	var (
-		data   map[string]any
+		data   model.GcpPeeringListResponse
 		failed map[string]any
-		path   = fmt.Sprintf("/api/instances/%v/vpc-peering", instanceID)
+		path   = vpcPeeringPath(instanceID, vpcID)
 	)
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
@@ -126,7 +106,7 @@ func (api *API) ReadVpcGcpPeering(ctx context.Context, instanceID, sleep, timeou
 
 	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s sleep=%d timeout=%d", path, sleep, timeout))
 	err := api.callWithRetry(ctxTimeout, api.sling.New().Get(path), retryRequest{
-		functionName:    "ReadVpcGcpPeering",
+		functionName:    "ListVpcGcpPeerings",
 		resourceName:    "VPC GCP Peering",
 		attempt:         1,
 		sleep:           time.Duration(sleep) * time.Second,
@@ -138,35 +118,41 @@ func (api *API) ReadVpcGcpPeering(ctx context.Context, instanceID, sleep, timeou
 		return nil, err
 	}
 
-	if len(data) == 0 {
-		return nil, nil
-	}
-
-	return data, nil
+	return data.Rows, nil
 }
 
-// UpdateVpcGcpPeering: updates a VPC peering from an instance.
-func (api *API) UpdateVpcGcpPeering(ctx context.Context, instanceID int, sleep, timeout int) (
-	map[string]any, error) {
+// ReadVpcGcpPeering: reads a VPC peering by its name, returns nil if not found.
+func (api *API) ReadVpcGcpPeering(ctx context.Context, instanceID int64, vpcID, peerID string,
+	sleep, timeout int) (*model.GcpPeeringResponse, error) {
 
-	// NOP just read out the VPC peering
-	return api.ReadVpcGcpPeering(ctx, instanceID, sleep, timeout)
+	peerings, err := api.ListVpcGcpPeerings(ctx, instanceID, vpcID, sleep, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, peering := range peerings {
+		if peering.Name == peerID {
+			return &peering, nil
+		}
+	}
+
+	return nil, nil
 }
 
-// RemoveVpcGcpPeering: removes a VPC peering from an instance.
-func (api *API) RemoveVpcGcpPeering(ctx context.Context, instanceID int, peerID string, sleep,
-	timeout int) error {
+// RemoveVpcGcpPeering: removes a VPC peering from an instance or a standalone VPC.
+func (api *API) RemoveVpcGcpPeering(ctx context.Context, instanceID int64, vpcID, peerID string,
+	sleep, timeout int) error {
 
 	var (
 		failed map[string]any
-		path   = fmt.Sprintf("/api/instances/%d/vpc-peering/%s", instanceID, peerID)
+		path   = fmt.Sprintf("%s/%s", vpcPeeringPath(instanceID, vpcID), peerID)
 	)
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
 	defer cancel()
 
 	tflog.Debug(ctx, fmt.Sprintf("method=DELETE path=%s sleep=%d timeout=%d", path, sleep, timeout))
-	err := api.callWithRetry(ctxTimeout, api.sling.New().Delete(path), retryRequest{
+	return api.callWithRetry(ctxTimeout, api.sling.New().Delete(path), retryRequest{
 		functionName:    "RemoveVpcGcpPeering",
 		resourceName:    "VPC GCP Peering",
 		attempt:         1,
@@ -175,21 +161,29 @@ func (api *API) RemoveVpcGcpPeering(ctx context.Context, instanceID int, peerID
 		failed:          &failed,
 		customRetryCode: 400,
 	})
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
 
 // ReadVpcGcpInfo: reads the VPC info from the API
 func (api *API) ReadVpcGcpInfo(ctx context.Context, instanceID, sleep, timeout int) (
 	map[string]any, error) {
 
+	return api.readVpcGcpInfo(ctx, "ReadVpcGcpInfo", vpcPeeringPath(int64(instanceID), ""), sleep, timeout)
+}
+
+// ReadVpcGcpInfoWithVpcId: reads the VPC info from the API
+func (api *API) ReadVpcGcpInfoWithVpcId(ctx context.Context, vpcID string, sleep, timeout int) (
+	map[string]any, error) {
+
+	return api.readVpcGcpInfo(ctx, "ReadVpcGcpInfoWithVpcId", vpcPeeringPath(0, vpcID), sleep, timeout)
+}
+
+func (api *API) readVpcGcpInfo(ctx context.Context, functionName, basePath string, sleep,
+	timeout int) (map[string]any, error) {
+
 	var (
 		data   map[string]any
 		failed map[string]any
-		path   = fmt.Sprintf("/api/instances/%d/vpc-peering/info", instanceID)
+		path   = fmt.Sprintf("%s/info", basePath)
 	)
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
@@ -197,7 +191,7 @@ func (api *API) ReadVpcGcpInfo(ctx context.Context, instanceID, sleep, timeout i
 
 	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s, sleep=%d, timeout=%d", path, sleep, timeout))
 	err := api.callWithRetry(ctxTimeout, api.sling.New().Get(path), retryRequest{
-		functionName:    "ReadVpcGcpInfo",
+		functionName:    functionName,
 		resourceName:    "VPC GCP Info",
 		attempt:         1,
 		sleep:           time.Duration(sleep) * time.Second,
@@ -5,81 +5,57 @@ import (
 	"fmt"
 	"time"
 
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func (api *API) AcceptVpcPeering(ctx context.Context, instanceID int, peeringID string, sleep,
-	timeout int) (map[string]any, error) {
+// vpcPeeringPath: base path for VPC peering endpoints, identified by either the VPC or the
+// instance identifier.
+func vpcPeeringPath(instanceID int64, vpcID string) string {
+	if vpcID != "" {
+		return fmt.Sprintf("/api/vpcs/%s/vpc-peering", vpcID)
+	}
+	return fmt.Sprintf("/api/instances/%d/vpc-peering", instanceID)
+}
+
+// AcceptVpcPeering: waits for the AWS peering request to be available and accepts it.
+func (api *API) AcceptVpcPeering(ctx context.Context, instanceID int64, vpcID, peeringID string,
+	sleep, timeout int) error {
 
 	var (
-		data   map[string]any
-		failed map[string]any
+		failed   map[string]any
+		basePath = vpcPeeringPath(instanceID, vpcID)
 	)
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
 	defer cancel()
 
-	attempt, err := api.waitForPeeringStatus(ctx, instanceID, peeringID, 1, sleep, timeout)
+	attempt, err := api.waitForPeeringStatus(ctx, basePath, peeringID, 1, sleep, timeout)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	path := fmt.Sprintf("/api/instances/%d/vpc-peering/request/%s", instanceID, peeringID)
+	path := fmt.Sprintf("%s/request/%s", basePath, peeringID)
 	tflog.Debug(ctx, fmt.Sprintf("method=PUT path=%s sleep=%d timeout=%d", path, sleep, timeout))
-	err = api.callWithRetry(ctxTimeout, api.sling.New().Put(path), retryRequest{
+	return api.callWithRetry(ctxTimeout, api.sling.New().Put(path), retryRequest{
 		functionName:    "AcceptVpcPeering",
 		resourceName:    "VPC Peering",
 		attempt:         attempt,
 		sleep:           time.Duration(sleep) * time.Second,
-		data:            &data,
+		data:            nil,
 		failed:          &failed,
 		customRetryCode: 400,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return data, nil
 }
 
-func (api *API) ReadVpcInfo(ctx context.Context, instanceID int) (map[string]any, error) {
-	var (
-		data   map[string]any
-		failed map[string]any
-		path   = fmt.Sprintf("/api/instances/%d/vpc-peering/info", instanceID)
-	)
-
-	ctxTimeout, cancel := context.WithTimeout(ctx, 100*time.Second)
-	defer cancel()
-
-	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s", path))
-	err := api.callWithRetry(ctxTimeout, api.sling.New().Get(path), retryRequest{
-		functionName:    "ReadVpcInfo",
-		resourceName:    "VPC Info",
-		attempt:         1,
-		sleep:           20 * time.Second,
-		data:            &data,
-		failed:          &failed,
-		customRetryCode: 400,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	if len(data) == 0 {
-		return nil, nil
-	}
-
-	return data, nil
-}
-
-func (api *API) ReadVpcPeeringRequest(ctx context.Context, instanceID int, peeringID string) (
-	map[string]any, error) {
+// ReadVpcPeeringRequest: reads the AWS peering request, returns nil if not found.
+func (api *API) ReadVpcPeeringRequest(ctx context.Context, instanceID int64, vpcID,
+	peeringID string) (*model.AwsPeeringResponse, error) {
 
 	var (
-		data   map[string]any
+		data   model.AwsPeeringResponse
 		failed map[string]any
-		path   = fmt.Sprintf("/api/instances/%d/vpc-peering/request/%s", instanceID, peeringID)
+		path   = fmt.Sprintf("%s/request/%s", vpcPeeringPath(instanceID, vpcID), peeringID)
 	)
 
 	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s", path))
@@ -95,26 +71,28 @@ func (api *API) ReadVpcPeeringRequest(ctx context.Context, instanceID int, peeri
 		return nil, err
 	}
 
-	if len(data) == 0 {
+	// Handle resource drift
+	if data.VpcPeeringConnectionID == "" {
 		return nil, nil
 	}
 
-	return data, nil
+	return &data, nil
 }
 
-func (api *API) RemoveVpcPeering(ctx context.Context, instanceID int, peeringID string,
+// RemoveVpcPeering: removes the AWS peering.
+func (api *API) RemoveVpcPeering(ctx context.Context, instanceID int64, vpcID, peeringID string,
 	sleep, timeout int) error {
 
 	var (
 		failed map[string]any
-		path   = fmt.Sprintf("/api/instances/%v/vpc-peering/%v", instanceID, peeringID)
+		path   = fmt.Sprintf("%s/%s", vpcPeeringPath(instanceID, vpcID), peeringID)
 	)
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
 	defer cancel()
 
 	tflog.Debug(ctx, fmt.Sprintf("method=DELETE path=%s sleep=%d timeout=%d", path, sleep, timeout))
-	err := api.callWithRetry(ctxTimeout, api.sling.New().Delete(path), retryRequest{
+	return api.callWithRetry(ctxTimeout, api.sling.New().Delete(path), retryRequest{
 		functionName:    "RemoveVpcPeering",
 		resourceName:    "VPC Peering",
 		attempt:         1,
@@ -123,24 +101,53 @@ func (api *API) RemoveVpcPeering(ctx context.Context, instanceID int, peeringID
 		failed:          &failed,
 		customRetryCode: 400,
 	})
+}
+
+func (api *API) ReadVpcInfo(ctx context.Context, instanceID int) (map[string]any, error) {
+	return api.readVpcInfo(ctx, "ReadVpcInfo", vpcPeeringPath(int64(instanceID), ""))
+}
+
+func (api *API) ReadVpcInfoWithVpcId(ctx context.Context, vpcID string) (map[string]any, error) {
+	return api.readVpcInfo(ctx, "ReadVpcInfoWithVpcId", vpcPeeringPath(0, vpcID))
+}
+
+func (api *API) readVpcInfo(ctx context.Context, functionName, basePath string) (map[string]any, error) {
+	var (
+		data   map[string]any
+		failed map[string]any
+		path   = fmt.Sprintf("%s/info", basePath)
+	)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, 100*time.Second)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s", path))
+	err := api.callWithRetry(ctxTimeout, api.sling.New().Get(path), retryRequest{
+		functionName:    functionName,
+		resourceName:    "VPC Info",
+		attempt:         1,
+		sleep:           20 * time.Second,
+		data:            &data,
+		failed:          &failed,
+		customRetryCode: 400,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	return data, nil
 }
 
-func (api *API) waitForPeeringStatus(ctx context.Context, instanceID int, peeringID string,
+func (api *API) waitForPeeringStatus(ctx context.Context, basePath, peeringID string,
 	attempt, sleep, timeout int) (int, error) {
 
 	time.Sleep(10 * time.Second)
-	path := fmt.Sprintf("/api/instances/%v/vpc-peering/status/%v", instanceID, peeringID)
+	path := fmt.Sprintf("%s/status/%s", basePath, peeringID)
 	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s sleep=%d timeout=%d ", path, sleep, timeout))
-	return api.waitForPeeringStatusWithRetry(ctx, path, peeringID, attempt, sleep, timeout)
-}
-
-func (api *API) waitForPeeringStatusWithRetry(ctx context.Context, path, peeringID string,
-	attempt, sleep, timeout int) (int, error) {
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
 	defer cancel()
@@ -151,13 +158,13 @@ func (api *API) waitForPeeringStatusWithRetry(ctx context.Context, path, peering
 		}
 
 		var (
-			data   map[string]any
+			data   model.PeeringStatusResponse
 			failed map[string]any
 		)
 
 		tflog.Debug(ctx, fmt.Sprintf("Checking VPC peering status, attempt=%d", attempt))
 		err := api.callWithRetry(ctxTimeout, api.sling.New().Get(path), retryRequest{
-			functionName: "waitForPeeringStatusWithRetry",
+			functionName: "waitForPeeringStatus",
 			resourceName: "VPC Peering",
 			attempt:      attempt,
 			sleep:        time.Duration(sleep) * time.Second,
@@ -168,20 +175,16 @@ func (api *API) waitForPeeringStatusWithRetry(ctx context.Context, path, peering
 			return attempt, err
 		}
 
-		// Check the status field
-		status, ok := data["status"].(string)
-		if !ok {
+		switch data.Status {
+		case "":
 			return attempt, fmt.Errorf("status field missing or invalid in response")
-		}
-
-		switch status {
 		case "active", "pending-acceptance":
 			return attempt, nil
 		case "deleted":
 			return attempt, fmt.Errorf("peering=%s has been deleted", peeringID)
 		default:
 			// Status is not ready yet, sleep and retry
-			tflog.Debug(ctx, fmt.Sprintf("VPC peering status=%s, not ready yet, attempt=%d", status, attempt))
+			tflog.Debug(ctx, fmt.Sprintf("VPC peering status=%s, not ready yet, attempt=%d", data.Status, attempt))
 			attempt++
 			select {
 			case <-ctxTimeout.Done():
@@ -68,6 +68,33 @@ func (api *API) ReadWebhook(ctx context.Context, instanceID int64, webhookID str
 	return &data, nil
 }
 
+// ListWebhooks - list all webhooks for an instance
+func (api *API) ListWebhooks(ctx context.Context, instanceID int64, sleep time.Duration) (
+	[]model.WebhookResponse, error) {
+
+	var (
+		data   []model.WebhookResponse
+		failed map[string]any
+		path   = fmt.Sprintf("/api/instances/%d/webhooks", instanceID)
+	)
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s ", path))
+	err := api.callWithRetry(ctx, api.sling.New().Get(path), retryRequest{
+		functionName: "ListWebhooks",
+		resourceName: "Webhook",
+		attempt:      1,
+		sleep:        sleep,
+		data:         &data,
+		failed:       &failed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("method=GET path=%s data=%v ", path, data))
+	return data, nil
+}
+
 // UpdateWebhook - updates a specific webhook for an instance
 func (api *API) UpdateWebhook(ctx context.Context, instanceID int64, webhookID string,
 	params model.WebhookUpdateRequest, sleep time.Duration) error {
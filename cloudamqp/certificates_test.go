@@ -0,0 +1,90 @@
+package cloudamqp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCertificateOptions: certificate generated by testCertificate, self signed when Issuer is nil.
+type testCertificateOptions struct {
+	CommonName string
+	IsCA       bool
+	NotAfter   time.Time
+	DNSNames   []string
+	Issuer     *testCertificateKeyPair
+}
+
+type testCertificateKeyPair struct {
+	Certificate    *x509.Certificate
+	Key            *ecdsa.PrivateKey
+	CertificatePEM string
+	PrivateKeyPEM  string
+}
+
+// testCertificate generates a certificate and private key for tests with certificates, instead of
+// recorded environment variables.
+func testCertificate(t *testing.T, opts testCertificateOptions) *testCertificateKeyPair {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.NotAfter.IsZero() {
+		opts.NotAfter = time.Now().AddDate(1, 0, 0)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: opts.CommonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              opts.NotAfter,
+		DNSNames:              opts.DNSNames,
+		BasicConstraintsValid: true,
+		IsCA:                  opts.IsCA,
+	}
+	if opts.IsCA {
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+
+	parent, signer := template, key
+	if opts.Issuer != nil {
+		parent, signer = opts.Issuer.Certificate, opts.Issuer.Key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCertificateKeyPair{
+		Certificate:    certificate,
+		Key:            key,
+		CertificatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKeyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})),
+	}
+}
+
+// testHCLString escapes newlines of PEM encoded data, to be used in a quoted HCL string.
+func testHCLString(data string) string {
+	return strings.ReplaceAll(data, "\n", `\n`)
+}
@@ -0,0 +1,132 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &featureFlagsDataSource{}
+	_ datasource.DataSourceWithConfigure = &featureFlagsDataSource{}
+)
+
+type featureFlagsDataSource struct {
+	client *api.API
+}
+
+func NewFeatureFlagsDataSource() datasource.DataSource {
+	return &featureFlagsDataSource{}
+}
+
+type featureFlagsDataSourceModel struct {
+	ID         types.String                     `tfsdk:"id"`
+	InstanceID types.Int64                      `tfsdk:"instance_id"`
+	Flags      []featureFlagDataSourceFlagModel `tfsdk:"flags"`
+}
+
+type featureFlagDataSourceFlagModel struct {
+	Name        types.String `tfsdk:"name"`
+	State       types.String `tfsdk:"state"`
+	Stability   types.String `tfsdk:"stability"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *featureFlagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_feature_flags"
+}
+
+func (d *featureFlagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the RabbitMQ feature flags and their state for an instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier for this data source",
+			},
+			"instance_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Instance identifier",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"flags": schema.ListNestedBlock{
+				Description: "Feature flags on the instance",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the feature flag",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "State of the feature flag (enabled, disabled, state_changing)",
+						},
+						"stability": schema.StringAttribute{
+							Computed:    true,
+							Description: "Stability of the feature flag (required, stable, experimental)",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the feature flag",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *featureFlagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *featureFlagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config featureFlagsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := config.InstanceID.ValueInt64()
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	flags, err := d.client.ListFeatureFlags(timeoutCtx, instanceID, 5*time.Second)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Feature Flags",
+			fmt.Sprintf("Could not read feature flags for instance %d: %s", instanceID, err.Error()),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%d", instanceID))
+	config.Flags = make([]featureFlagDataSourceFlagModel, len(flags))
+	for i, flag := range flags {
+		config.Flags[i] = featureFlagDataSourceFlagModel{
+			Name:        types.StringValue(flag.Name),
+			State:       types.StringValue(flag.State),
+			Stability:   types.StringValue(flag.Stability),
+			Description: types.StringValue(flag.Description),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,174 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &integrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &integrationsDataSource{}
+)
+
+type integrationsDataSource struct {
+	client *api.API
+}
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &integrationsDataSource{}
+}
+
+type integrationsDataSourceModel struct {
+	ID         types.String                        `tfsdk:"id"`
+	InstanceID types.Int64                         `tfsdk:"instance_id"`
+	Logs       []integrationsDataSourceLogModel    `tfsdk:"logs"`
+	Metrics    []integrationsDataSourceMetricModel `tfsdk:"metrics"`
+}
+
+type integrationsDataSourceLogModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type integrationsDataSourceMetricModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	MetricsFilter types.List   `tfsdk:"metrics_filter"`
+}
+
+func (d *integrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_integrations"
+}
+
+func (d *integrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the log and metric integrations on an instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier for this data source",
+			},
+			"instance_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Instance identifier",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"logs": schema.ListNestedBlock{
+				Description: "Log integrations on the instance",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the log integration",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the log integration",
+						},
+					},
+				},
+			},
+			"metrics": schema.ListNestedBlock{
+				Description: "Metric integrations on the instance",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the metric integration",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the metric integration",
+						},
+						"metrics_filter": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Metrics sent by the integration, empty when all metrics are sent",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *integrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config integrationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := config.InstanceID.ValueInt64()
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	logs, err := d.client.ListIntegrationLogs(timeoutCtx, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Log Integrations",
+			fmt.Sprintf("Could not read log integrations for instance %d: %s", instanceID, err.Error()),
+		)
+		return
+	}
+
+	metrics, err := d.client.ListIntegrationMetrics(timeoutCtx, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Metric Integrations",
+			fmt.Sprintf("Could not read metric integrations for instance %d: %s", instanceID, err.Error()),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%d", instanceID))
+	config.Logs = make([]integrationsDataSourceLogModel, len(logs))
+	for i, log := range logs {
+		config.Logs[i] = integrationsDataSourceLogModel{
+			ID:   types.StringValue(fmt.Sprintf("%d", log.ID)),
+			Name: types.StringValue(log.Type),
+		}
+	}
+
+	config.Metrics = make([]integrationsDataSourceMetricModel, len(metrics))
+	for i, metric := range metrics {
+		filter := metric.MetricsFilter
+		if filter == nil {
+			filter = []string{}
+		}
+		metricsFilter, diags := types.ListValueFrom(ctx, types.StringType, filter)
+		resp.Diagnostics.Append(diags...)
+		config.Metrics[i] = integrationsDataSourceMetricModel{
+			ID:            types.StringValue(fmt.Sprintf("%d", metric.ID)),
+			Name:          types.StringValue(metric.Type),
+			MetricsFilter: metricsFilter,
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
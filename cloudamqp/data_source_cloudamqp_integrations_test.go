@@ -0,0 +1,70 @@
+package cloudamqp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccIntegrationsDatasource_Basic: list log and metric integrations on an instance.
+func TestAccIntegrationsDatasource_Basic(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	dataSourceName := "data.cloudamqp_integrations.integrations"
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "cloudamqp_integrations" "integrations" {
+						instance_id = %d
+					}`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprint(instanceID)),
+					resource.TestCheckResourceAttr(dataSourceName, "logs.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_integration_log" "loki" {
+						instance_id = %[1]d
+						name        = "loki"
+						url         = "https://logs-prod-eu-west-0.grafana.net"
+					}
+
+					resource "cloudamqp_integration_metric" "opentelemetry" {
+						instance_id = %[1]d
+						name        = "opentelemetry"
+						endpoint    = "https://otlp.example.com:4318"
+					}
+
+					data "cloudamqp_integrations" "integrations" {
+						instance_id = %[1]d
+
+						depends_on = [
+							cloudamqp_integration_log.loki,
+							cloudamqp_integration_metric.opentelemetry,
+						]
+					}`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logs.0.id",
+						"cloudamqp_integration_log.loki", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "logs.0.name", "loki"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metrics.0.id",
+						"cloudamqp_integration_metric.opentelemetry", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0.name", "opentelemetry"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0.metrics_filter.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &webhooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhooksDataSource{}
+)
+
+type webhooksDataSource struct {
+	client *api.API
+}
+
+func NewWebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+type webhooksDataSourceModel struct {
+	ID         types.String                     `tfsdk:"id"`
+	InstanceID types.Int64                      `tfsdk:"instance_id"`
+	Webhooks   []webhooksDataSourceWebhookModel `tfsdk:"webhooks"`
+}
+
+type webhooksDataSourceWebhookModel struct {
+	ID             types.String `tfsdk:"id"`
+	Vhost          types.String `tfsdk:"vhost"`
+	Queue          types.String `tfsdk:"queue"`
+	WebhookURI     types.String `tfsdk:"webhook_uri"`
+	Concurrency    types.Int64  `tfsdk:"concurrency"`
+	DeliveryStatus types.String `tfsdk:"delivery_status"`
+}
+
+func (d *webhooksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_webhooks"
+}
+
+func (d *webhooksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to retrieve the webhooks on an instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier for this data source",
+			},
+			"instance_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Instance identifier",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"webhooks": schema.ListNestedBlock{
+				Description: "Webhooks on the instance",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the webhook",
+						},
+						"vhost": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the virtual host",
+						},
+						"queue": schema.StringAttribute{
+							Computed:    true,
+							Description: "The queue forwarded to the endpoint",
+						},
+						"webhook_uri": schema.StringAttribute{
+							Computed:    true,
+							Description: "The endpoint messages are forwarded to",
+						},
+						"concurrency": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Max simultaneous requests to the endpoint",
+						},
+						"delivery_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the last delivery made to the endpoint",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *webhooksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config webhooksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := config.InstanceID.ValueInt64()
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	webhooks, err := d.client.ListWebhooks(timeoutCtx, instanceID, 10*time.Second)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Webhooks",
+			fmt.Sprintf("Could not read webhooks for instance %d: %s", instanceID, err.Error()),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%d", instanceID))
+	config.Webhooks = make([]webhooksDataSourceWebhookModel, len(webhooks))
+	for i, webhook := range webhooks {
+		config.Webhooks[i] = webhooksDataSourceWebhookModel{
+			ID:             types.StringValue(fmt.Sprintf("%d", webhook.ID)),
+			Vhost:          types.StringValue(webhook.Vhost),
+			Queue:          types.StringValue(webhook.Queue),
+			WebhookURI:     types.StringValue(webhook.WebhookURI),
+			Concurrency:    types.Int64Value(webhook.Concurrency),
+			DeliveryStatus: types.StringPointerValue(webhook.LastStatus),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,54 @@
+package cloudamqp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccWebhooksDatasource_Basic: list webhooks on an instance.
+func TestAccWebhooksDatasource_Basic(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	dataSourceName := "data.cloudamqp_webhooks.webhooks"
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_webhook" "webhook_queue" {
+						instance_id = %[1]d
+						vhost       = "vhost"
+						queue       = "webhook-queue"
+						webhook_uri = "https://example.com/webhook"
+						concurrency = 2
+					}
+
+					data "cloudamqp_webhooks" "webhooks" {
+						instance_id = %[1]d
+
+						depends_on = [
+							cloudamqp_webhook.webhook_queue,
+						]
+					}`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprint(instanceID)),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhooks.0.id",
+						"cloudamqp_webhook.webhook_queue", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.vhost", "vhost"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.queue", "webhook-queue"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.webhook_uri", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhooks.0.concurrency", "2"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "webhooks.0.delivery_status"),
+				),
+			},
+		},
+	})
+}
@@ -98,7 +98,10 @@ func (p *cloudamqpProvider) Configure(ctx context.Context, request provider.Conf
 func (p *cloudamqpProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAlarmDataSource,
+		NewFeatureFlagsDataSource,
+		NewIntegrationsDataSource,
 		NewNotificationDataSource,
+		NewWebhooksDataSource,
 	}
 }
 
@@ -108,8 +111,12 @@ func (p *cloudamqpProvider) Resources(_ context.Context) []func() resource.Resou
 		NewAlarmResource,
 		NewAwsEventBridgeResource,
 		NewCustomCertificateResource,
+		NewCustomDomainResource,
+		NewFeatureFlagsResource,
 		NewIntegrationLogResource,
 		NewIntegrationMetricResource,
+		NewIntegrationMetricPrometheusResource,
+		NewLavinMqConfigurationResource,
 		NewMaintenanceWindowResource,
 		NewNodeActionsResource,
 		NewNotificationResource,
@@ -117,6 +124,10 @@ func (p *cloudamqpProvider) Resources(_ context.Context) []func() resource.Resou
 		NewPluginBatchResource,
 		NewRabbitMqConfigurationResource,
 		NewTrustStoreResource,
+		NewVpcAzurePeeringResource,
+		NewVpcConnectResource,
+		NewVpcGcpPeeringResource,
+		NewVpcPeeringResource,
 		NewVpcResource,
 		NewWebhookResource,
 	}
@@ -166,20 +177,15 @@ func Provider(v string, client *http.Client) *schemaSdk.Provider {
 			"cloudamqp_vpc_info":            dataSourceVpcInfo(),
 		},
 		ResourcesMap: map[string]*schemaSdk.Resource{
-			"cloudamqp_custom_domain":                 resourceCustomDomain(),
-			"cloudamqp_extra_disk_size":               resourceExtraDiskSize(),
-			"cloudamqp_instance":                      resourceInstance(),
-			"cloudamqp_integration_metric_prometheus": resourceIntegrationMetricPrometheus(),
-			"cloudamqp_plugin_community":              resourcePluginCommunity(),
-			"cloudamqp_plugin":                        resourcePlugin(),
-			"cloudamqp_privatelink_aws":               resourcePrivateLinkAws(),
-			"cloudamqp_privatelink_azure":             resourcePrivateLinkAzure(),
-			"cloudamqp_security_firewall":             resourceSecurityFirewall(),
-			"cloudamqp_upgrade_rabbitmq":              resourceUpgradeRabbitMQ(),
-			"cloudamqp_upgrade_lavinmq":               resourceUpgradeLavinMQ(),
-			"cloudamqp_vpc_connect":                   resourceVpcConnect(),
-			"cloudamqp_vpc_gcp_peering":               resourceVpcGcpPeering(),
-			"cloudamqp_vpc_peering":                   resourceVpcPeering(),
+			"cloudamqp_extra_disk_size":   resourceExtraDiskSize(),
+			"cloudamqp_instance":          resourceInstance(),
+			"cloudamqp_plugin_community":  resourcePluginCommunity(),
+			"cloudamqp_plugin":            resourcePlugin(),
+			"cloudamqp_privatelink_aws":   resourcePrivateLinkAws(),
+			"cloudamqp_privatelink_azure": resourcePrivateLinkAzure(),
+			"cloudamqp_security_firewall": resourceSecurityFirewall(),
+			"cloudamqp_upgrade_rabbitmq":  resourceUpgradeRabbitMQ(),
+			"cloudamqp_upgrade_lavinmq":   resourceUpgradeLavinMQ(),
 		},
 		ConfigureContextFunc: configureClient(client),
 	}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/cassettelint"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/sanitizer"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -20,6 +25,8 @@ import (
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
+const cassetteDir = "../test/fixtures/vcr"
+
 var (
 	mode = recorder.ModeReplayOnly
 )
@@ -54,7 +61,7 @@ func TestMain(m *testing.M) {
 
 func cloudamqpResourceTest(t *testing.T, c resource.TestCase) {
 	rec, err := recorder.NewWithOptions(&recorder.Options{
-		CassetteName:       fmt.Sprintf("../test/fixtures/vcr/%s", t.Name()),
+		CassetteName:       fmt.Sprintf("%s/%s", cassetteDir, t.Name()),
 		Mode:               mode,
 		SkipRequestLatency: true,
 	})
@@ -63,18 +70,9 @@ func cloudamqpResourceTest(t *testing.T, c resource.TestCase) {
 	}
 	defer rec.Stop()
 
-	sanitizeHook := func(i *cassette.Interaction) error {
-		i.Request.Headers["Authorization"] = []string{"REDACTED"}
-		i.Response.Headers["Set-Cookie"] = []string{"REDACTED"}
-		// Sanitize URLs containing passwords
-		i.Response.Body = sanitizer.URL(i.Response.Body)
-		// Filter sensitive data API keys, secrects and tokens from request and response bodies
-		i.Request.Body = sanitizeSensistiveData(i.Request.Body)
-		i.Response.Body = sanitizeSensistiveData(i.Response.Body)
-		return nil
-	}
-	rec.SetMatcher(requestURIMatcher)
-	rec.AddHook(sanitizeHook, recorder.BeforeSaveHook)
+	tracker := cassettelint.NewTracker(requestURIMatcher)
+	rec.SetMatcher(tracker.Matcher)
+	rec.AddHook(cassetteSanitizer(t.Name()).Hook, recorder.BeforeSaveHook)
 
 	shouldSaveHook := func(i *cassette.Interaction) error {
 		if t.Failed() {
@@ -185,13 +183,94 @@ func cloudamqpResourceTest(t *testing.T, c resource.TestCase) {
 		return req.URL.Path == "/login"
 	})
 
-	c.ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	c.ProtoV5ProviderFactories = testAccProtoV5ProviderFactories(rec.GetDefaultClient())
+	resource.Test(t, c)
+
+	if mode == recorder.ModeReplayOnly && !t.Failed() {
+		checkUnmatchedInteractions(t, tracker)
+	}
+}
+
+// checkUnmatchedInteractions: reports interactions in the cassette never replayed, fails the test
+// when CLOUDAMQP_VCR_STRICT is set.
+func checkUnmatchedInteractions(t *testing.T, tracker *cassettelint.Tracker) {
+	c, err := cassettelint.Load(cassetteDir, t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, finding := range tracker.CheckUnmatched(t.Name(), c) {
+		if os.Getenv("CLOUDAMQP_VCR_STRICT") != "" {
+			t.Error(finding)
+		} else {
+			t.Log(finding)
+		}
+	}
+}
+
+// cloudamqpLocalResourceTest runs the test case against a local httptest server serving handler,
+// instead of replaying recorded VCR cassettes.
+func cloudamqpLocalResourceTest(t *testing.T, handler http.Handler, c resource.TestCase) {
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	cloudamqpServerResourceTest(t, server.URL, c)
+}
+
+// cloudamqpFakeAPIResourceTest runs the test case against the stateful fake API server.
+func cloudamqpFakeAPIResourceTest(t *testing.T, server *fakeapi.Server, c resource.TestCase) {
+	cloudamqpServerResourceTest(t, server.URL, c)
+}
+
+func cloudamqpServerResourceTest(t *testing.T, serverURL string, c resource.TestCase) {
+	target, err := url.Parse(serverURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: localTransport{target: target}}
+	c.ProtoV5ProviderFactories = testAccProtoV5ProviderFactories(client)
+	resource.Test(t, c)
+}
+
+// fakeAPIInstance creates an instance in the fake API server, for resources depending on one.
+func fakeAPIInstance(t *testing.T, server *fakeapi.Server) int64 {
+	t.Helper()
+
+	data, err := server.API().CreateInstance(context.Background(), map[string]any{
+		"name":   t.Name(),
+		"plan":   "bunny-1",
+		"region": "amazon-web-services::us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	instanceID, err := strconv.ParseInt(fmt.Sprint(data["id"]), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return instanceID
+}
+
+// localTransport redirects all requests to the target server, independent of the configured base URL.
+type localTransport struct {
+	target *url.URL
+}
+
+func (lt localTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = lt.target.Scheme
+	req.URL.Host = lt.target.Host
+	req.Host = lt.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func testAccProtoV5ProviderFactories(client *http.Client) map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
 		"cloudamqp": func() (tfprotov5.ProviderServer, error) {
 			ctx := context.Background()
 
 			muxServer, err := tf5muxserver.NewMuxServer(ctx,
-				Provider("1.0", rec.GetDefaultClient()).GRPCProvider,
-				providerserver.NewProtocol5(New("1.0", rec.GetDefaultClient())),
+				Provider("1.0", client).GRPCProvider,
+				providerserver.NewProtocol5(New("1.0", client)),
 			)
 
 			if err != nil {
@@ -201,8 +280,6 @@ func cloudamqpResourceTest(t *testing.T, c resource.TestCase) {
 			return muxServer.ProviderServer(), nil
 		},
 	}
-
-	resource.Test(t, c)
 }
 
 func requestURIMatcher(request *http.Request, interaction cassette.Request) bool {
@@ -216,36 +293,52 @@ func requestURIMatcher(request *http.Request, interaction cassette.Request) bool
 	return request.Method == interaction.Method && request.URL.RequestURI() == interactionURI.RequestURI()
 }
 
-func sanitizeSensistiveData(body string) string {
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("AZM_APPLICATION_SECRET"), "AZM_APPLICATION_SECRET")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("AZM_INSTRUMENTATION_KEY"), "AZM_INSTRUMENTATION_KEY")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("AZM_INSTRUMENTATION_KEY_2"), "AZM_INSTRUMENTATION_KEY_2")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("CLOUDWATCH_ACCESS_KEY_ID"), "CLOUDWATCH_ACCESS_KEY_ID")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("CLOUDWATCH_SECRET_ACCESS_KEY"), "CLOUDWATCH_SECRET_ACCESS_KEY")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("CORALOGIX_SEND_DATA_KEY"), "CORALOGIX_SEND_DATA_KEY")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("DATADOG_APIKEY"), "DATADOG_APIKEY")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("DYNATRACE_TOKEN"), "DYNATRACE_TOKEN")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("DYNATRACE_TOKEN_2"), "DYNATRACE_TOKEN_2")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("LIBRATO_APIKEY"), "LIBRATO_APIKEY")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("LOGENTIRES_TOKEN"), "LOGENTIRES_TOKEN")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("LOGGLY_TOKEN"), "LOGGLY_TOKEN")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("NEWRELIC_APIKEY"), "NEWRELIC_APIKEY")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("SCALYR_TOKEN"), "SCALYR_TOKEN")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("SPLUNK_TOKEN"), "SPLUNK_TOKEN")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("SPLUNK_TOKEN_2"), "SPLUNK_TOKEN_2")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("TEST_CERTIFICATE_CA"), "TEST_CERTIFICATE_CA")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("TEST_CERTIFICATE_CERT"), "TEST_CERTIFICATE_CERT")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("TEST_CERTIFICATE_PRIVATE_KEY"), "TEST_CERTIFICATE_PRIVATE_KEY")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("TEST_TRUST_STORE_CA"), "TEST_TRUST_STORE_CA")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("TEST_TRUST_STORE_CERT"), "TEST_TRUST_STORE_CERT")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("TEST_TRUST_STORE_CERT_2"), "TEST_TRUST_STORE_CERT_2")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("RECIPIENT_OPSGENIE_VALUE"), "RECIPIENT_OPSGENIE_VALUE")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("RECIPIENT_SIGNL4_VALUE"), "RECIPIENT_SIGNL4_VALUE")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("RECIPIENT_PAGERDUTY_VALUE"), "RECIPIENT_PAGERDUTY_VALUE")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("RECIPIENT_TEAMS_VALUE"), "RECIPIENT_TEAMS_VALUE")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("RECIPIENT_VICTOROPS_VALUE"), "RECIPIENT_VICTOROPS_VALUE")
-	body = sanitizer.FilterSensitiveData(body, os.Getenv("RECIPIENT_SLACK_VALUE"), "RECIPIENT_SLACK_VALUE")
-	return body
+// sensitiveEnvVars: Environment variables with API keys, secrets and tokens used by the tests, their
+// values are replaced with the variable names in request and response bodies.
+var sensitiveEnvVars = []string{
+	"AZM_APPLICATION_SECRET",
+	"AZM_INSTRUMENTATION_KEY",
+	"AZM_INSTRUMENTATION_KEY_2",
+	"CLOUDWATCH_ACCESS_KEY_ID",
+	"CLOUDWATCH_SECRET_ACCESS_KEY",
+	"CORALOGIX_SEND_DATA_KEY",
+	"DATADOG_APIKEY",
+	"DYNATRACE_TOKEN",
+	"DYNATRACE_TOKEN_2",
+	"LIBRATO_APIKEY",
+	"LOGENTIRES_TOKEN",
+	"LOGGLY_TOKEN",
+	"NEWRELIC_APIKEY",
+	"SCALYR_TOKEN",
+	"SPLUNK_TOKEN",
+	"SPLUNK_TOKEN_2",
+	"TEST_CERTIFICATE_CA",
+	"TEST_CERTIFICATE_CERT",
+	"TEST_CERTIFICATE_PRIVATE_KEY",
+	"TEST_TRUST_STORE_CA",
+	"TEST_TRUST_STORE_CERT",
+	"TEST_TRUST_STORE_CERT_2",
+	"RECIPIENT_OPSGENIE_VALUE",
+	"RECIPIENT_SIGNL4_VALUE",
+	"RECIPIENT_PAGERDUTY_VALUE",
+	"RECIPIENT_TEAMS_VALUE",
+	"RECIPIENT_VICTOROPS_VALUE",
+	"RECIPIENT_SLACK_VALUE",
+}
+
+// cassetteSanitizer returns the rules used to sanitize the cassette recorded by the test, the same
+// rules are used when linting the cassettes.
+func cassetteSanitizer(testName string) *sanitizer.Sanitizer {
+	s := sanitizer.Default().With(sanitizer.EnvValues(sensitiveEnvVars...))
+	switch {
+	case strings.HasPrefix(testName, "TestAccIntegrationMetricPrometheusStackdriverV2_"):
+		// Service account credentials from test/fixtures, the fake private key is part of the test.
+		s = s.Without(sanitizer.PEMPrivateKeyRule, sanitizer.GCPServiceAccountRule)
+	case strings.HasPrefix(testName, "TestAccWebhook_"):
+		// The webhook URI query key is a placeholder checked against the state.
+		s = s.Without(sanitizer.WebhookTokenRule)
+	}
+	return s
 }
 
 // testAccImportCombinedIdFunc returns an ImportStateIdFunc that combines the resource ID and instance ID for import testing of resources with composite IDs.
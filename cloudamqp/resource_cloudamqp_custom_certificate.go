@@ -2,11 +2,18 @@ package cloudamqp
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
 	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -18,8 +25,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = &customCertificateResource{}
-	_ resource.ResourceWithConfigure = &customCertificateResource{}
+	_ resource.Resource                   = &customCertificateResource{}
+	_ resource.ResourceWithConfigure      = &customCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &customCertificateResource{}
+	_ resource.ResourceWithModifyPlan     = &customCertificateResource{}
 )
 
 type customCertificateResource struct {
@@ -39,6 +48,8 @@ type customCertificateResourceModel struct {
 	SNIHosts   types.String `tfsdk:"sni_hosts"`
 	Version    types.Int64  `tfsdk:"version"`
 	KeyID      types.String `tfsdk:"key_id"`
+	NotAfter   types.String `tfsdk:"not_after"`
+	Serial     types.String `tfsdk:"serial"`
 }
 
 func (r *customCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,6 +115,14 @@ func (r *customCertificateResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"not_after": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the certificate in RFC3339 format",
+			},
+			"serial": schema.StringAttribute{
+				Computed:    true,
+				Description: "Serial number of the certificate as hex",
+			},
 		},
 	}
 }
@@ -123,6 +142,74 @@ func (r *customCertificateResource) Configure(ctx context.Context, req resource.
 	r.client = client
 }
 
+func (r *customCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config customCertificateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, value := range []types.String{config.CA, config.Cert, config.PrivateKey, config.SNIHosts} {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+	}
+
+	roots, err := utils.ParsePEMCertificates(config.CA.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ca"), "Invalid Certificate Authority",
+			fmt.Sprintf("Failed to parse PEM encoded CA: %s", err))
+	}
+	chain, err := utils.ParsePEMCertificates(config.Cert.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cert"), "Invalid Certificate",
+			fmt.Sprintf("Failed to parse PEM encoded certificate: %s", err))
+	}
+	key, err := utils.ParsePEMPrivateKey(config.PrivateKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("private_key"), "Invalid Private Key",
+			fmt.Sprintf("Failed to parse PEM encoded private key: %s", err))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCustomCertificate(roots, chain, key,
+		customCertificateSNIHosts(config.SNIHosts.ValueString()))...)
+}
+
+// ModifyPlan: computes the certificate attributes from the write only certificate, only available
+// in the configuration. The certificate is only uploaded on create or replace, otherwise the
+// attributes are kept from the state.
+func (r *customCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan customCertificateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && len(resp.RequiresReplace) == 0 {
+		var state customCertificateResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		plan.NotAfter = state.NotAfter
+		plan.Serial = state.Serial
+	} else if !config.Cert.IsUnknown() {
+		chain, err := utils.ParsePEMCertificates(config.Cert.ValueString())
+		if err != nil {
+			// Reported by ValidateConfig
+			return
+		}
+		plan.NotAfter = types.StringValue(chain[0].NotAfter.UTC().Format(time.RFC3339))
+		plan.Serial = types.StringValue(customCertificateSerial(chain[0]))
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *customCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan customCertificateResourceModel
 	var config customCertificateResourceModel
@@ -196,3 +283,87 @@ func (r *customCertificateResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 }
+
+// customCertificateSNIHosts: splits the comma or space separated hostnames.
+func customCertificateSNIHosts(sniHosts string) []string {
+	return strings.FieldsFunc(sniHosts, func(c rune) bool {
+		return c == ',' || unicode.IsSpace(c)
+	})
+}
+
+// customCertificateSerial: serial number as upper case hex, e.g. 3A0F...
+func customCertificateSerial(certificate *x509.Certificate) string {
+	return strings.ToUpper(certificate.SerialNumber.Text(16))
+}
+
+// validateCustomCertificate: the first certificate in the chain is the server certificate, it
+// must belong to the private key, verify against the CA roots, with intermediates from the rest of
+// the chain, and cover all SNI hostnames. An expired certificate is reported as a warning.
+func validateCustomCertificate(roots, chain []*x509.Certificate, key crypto.Signer,
+	sniHosts []string) diag.Diagnostics {
+
+	var (
+		diags       diag.Diagnostics
+		certificate = chain[0]
+		subject     = certificate.Subject.String()
+	)
+
+	if !utils.PrivateKeyMatches(certificate, key) {
+		diags.AddAttributeError(path.Root("private_key"), "Private Key Mismatch",
+			fmt.Sprintf("The private key does not match the public key of certificate %q", subject))
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, root := range roots {
+		opts.Roots.AddCert(root)
+	}
+	for _, intermediate := range chain[1:] {
+		opts.Intermediates.AddCert(intermediate)
+	}
+	// Verify the chain within the validity window of the certificate, expiry is only a warning
+	now := time.Now()
+	switch {
+	case now.After(certificate.NotAfter):
+		opts.CurrentTime = certificate.NotAfter
+		diags.AddAttributeWarning(path.Root("cert"), "Certificate Expired",
+			fmt.Sprintf("Certificate %q expired at %s", subject,
+				certificate.NotAfter.UTC().Format(time.RFC3339)))
+	case now.Before(certificate.NotBefore):
+		opts.CurrentTime = certificate.NotBefore
+	}
+	if _, err := certificate.Verify(opts); err != nil {
+		diags.AddAttributeError(path.Root("cert"), "Invalid Certificate Chain",
+			fmt.Sprintf("Certificate %q does not verify against the CA: %s", subject, err))
+	}
+
+	var uncovered []string
+	for _, host := range sniHosts {
+		if !customCertificateCovers(certificate, host) {
+			uncovered = append(uncovered, host)
+		}
+	}
+	if len(uncovered) > 0 {
+		diags.AddAttributeError(path.Root("sni_hosts"), "SNI Hosts Not Covered",
+			fmt.Sprintf("Certificate %q is not valid for %s, subject alternative names: %s", subject,
+				strings.Join(uncovered, ", "), strings.Join(certificate.DNSNames, ", ")))
+	}
+	return diags
+}
+
+// customCertificateCovers: wildcard hostnames need to be listed as is in the subject alternative
+// names, other hostnames are matched against the names including wildcards.
+func customCertificateCovers(certificate *x509.Certificate, host string) bool {
+	if strings.HasPrefix(host, "*.") {
+		for _, name := range certificate.DNSNames {
+			if strings.EqualFold(name, host) {
+				return true
+			}
+		}
+		return false
+	}
+	return certificate.VerifyHostname(host) == nil
+}
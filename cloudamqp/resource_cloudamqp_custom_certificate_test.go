@@ -3,8 +3,12 @@ package cloudamqp
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -22,10 +26,10 @@ func TestAccCustomCertificate_Version(t *testing.T) {
 	instanceResourceName := "cloudamqp_instance.instance"
 	customCertificateResourceName := "cloudamqp_custom_certificate.custom_cert"
 
-	// Set sanitized value for playback and use real value for recording
-	testCertificateCA := "TEST_CERTIFICATE_CA"
-	testCertificateCert := "TEST_CERTIFICATE_CERT"
-	testCertificatePrivateKey := "TEST_CERTIFICATE_PRIVATE_KEY"
+	// Set generated certificates for playback and use real value for recording, request bodies are
+	// not matched against the cassette.
+	testCertificateCA, testCertificateCert, testCertificatePrivateKey := testCustomCertificate(t,
+		"my.custom.domain")
 	if os.Getenv("CLOUDAMQP_RECORD") != "" {
 		testCertificateCA = os.Getenv("TEST_CERTIFICATE_CA")
 		testCertificateCert = os.Getenv("TEST_CERTIFICATE_CERT")
@@ -95,10 +99,10 @@ func TestAccCustomCertificate_KeyID(t *testing.T) {
 	instanceResourceName := "cloudamqp_instance.instance"
 	customCertificateResourceName := "cloudamqp_custom_certificate.custom_cert"
 
-	// Set sanitized value for playback and use real value for recording
-	testCertificateCA := "TEST_CERTIFICATE_CA"
-	testCertificateCert := "TEST_CERTIFICATE_CERT"
-	testCertificatePrivateKey := "TEST_CERTIFICATE_PRIVATE_KEY"
+	// Set generated certificates for playback and use real value for recording, request bodies are
+	// not matched against the cassette.
+	testCertificateCA, testCertificateCert, testCertificatePrivateKey := testCustomCertificate(t,
+		"my.custom.domain")
 	if os.Getenv("CLOUDAMQP_RECORD") != "" {
 		testCertificateCA = os.Getenv("TEST_CERTIFICATE_CA")
 		testCertificateCert = os.Getenv("TEST_CERTIFICATE_CERT")
@@ -160,3 +164,128 @@ func TestAccCustomCertificate_KeyID(t *testing.T) {
 		},
 	})
 }
+
+// testCustomCertificate: generated CA, server certificate and private key for the hostnames, escaped
+// to be used in quoted HCL strings.
+func testCustomCertificate(t *testing.T, hostnames ...string) (string, string, string) {
+	t.Helper()
+
+	ca := testCertificate(t, testCertificateOptions{CommonName: "TEST_CERTIFICATE_CA", IsCA: true})
+	cert := testCertificate(t, testCertificateOptions{CommonName: hostnames[0], DNSNames: hostnames,
+		Issuer: ca})
+	return testHCLString(ca.CertificatePEM), testHCLString(cert.CertificatePEM),
+		testHCLString(cert.PrivateKeyPEM)
+}
+
+// TestAccCustomCertificate_Validation: Validate the certificate, private key and SNI hosts at plan
+// time and compute the certificate attributes.
+func TestAccCustomCertificate_Validation(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+
+	var (
+		customCertificateResourceName = "cloudamqp_custom_certificate.custom_cert"
+		rootCA                        = testCertificate(t, testCertificateOptions{CommonName: "Root CA", IsCA: true})
+		intermediateCA                = testCertificate(t, testCertificateOptions{CommonName: "Intermediate CA",
+			IsCA: true, Issuer: rootCA})
+		otherCA = testCertificate(t, testCertificateOptions{CommonName: "Other CA", IsCA: true})
+		cert    = testCertificate(t, testCertificateOptions{CommonName: "example.com",
+			DNSNames: []string{"example.com", "*.example.com"}, Issuer: intermediateCA})
+		otherCert = testCertificate(t, testCertificateOptions{CommonName: "other.example.com",
+			DNSNames: []string{"other.example.com"}, Issuer: otherCA})
+	)
+
+	config := func(ca, chain, privateKey, sniHosts string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_custom_certificate" "custom_cert" {
+				instance_id = %d
+				ca          = "%s"
+				cert        = "%s"
+				private_key = "%s"
+				sni_hosts   = "%s"
+			}`, instanceID, testHCLString(ca), testHCLString(chain), testHCLString(privateKey), sniHosts)
+	}
+
+	chain := cert.CertificatePEM + intermediateCA.CertificatePEM
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      config("not a certificate", chain, "not a key", "example.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Failed to parse PEM encoded CA.*Failed to parse PEM encoded\s+private key`),
+			},
+			{
+				Config:      config(rootCA.CertificatePEM, chain, otherCert.PrivateKeyPEM, "example.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The private key does not match the public key of certificate\s+"CN=example.com"`),
+			},
+			{
+				Config:      config(otherCA.CertificatePEM, chain, cert.PrivateKeyPEM, "example.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Certificate "CN=example.com" does not verify against the CA`),
+			},
+			{
+				Config:      config(rootCA.CertificatePEM, cert.CertificatePEM, cert.PrivateKeyPEM, "example.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`does not verify against the CA`),
+			},
+			{
+				Config: config(rootCA.CertificatePEM, chain, cert.PrivateKeyPEM,
+					"example.com,foo.example.com bar.other.com,*.other.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Certificate "CN=example.com" is not valid for bar.other.com,\s+\*.other.com`),
+			},
+			{
+				Config: config(rootCA.CertificatePEM, chain, cert.PrivateKeyPEM, "example.com,*.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(customCertificateResourceName, "sni_hosts", "example.com,*.example.com"),
+					resource.TestCheckResourceAttr(customCertificateResourceName, "not_after",
+						cert.Certificate.NotAfter.UTC().Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(customCertificateResourceName, "serial",
+						strings.ToUpper(cert.Certificate.SerialNumber.Text(16))),
+				),
+			},
+		},
+	})
+}
+
+// TestAccCustomCertificate_Expired: An expired certificate with a valid chain is only a warning
+// and does not fail the plan.
+func TestAccCustomCertificate_Expired(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+
+	var (
+		customCertificateResourceName = "cloudamqp_custom_certificate.custom_cert"
+		ca                            = testCertificate(t, testCertificateOptions{CommonName: "Root CA", IsCA: true})
+		cert                          = testCertificate(t, testCertificateOptions{CommonName: "example.com",
+			DNSNames: []string{"example.com"}, Issuer: ca, NotAfter: time.Now().Add(-time.Minute)})
+	)
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_custom_certificate" "custom_cert" {
+						instance_id = %d
+						ca          = "%s"
+						cert        = "%s"
+						private_key = "%s"
+						sni_hosts   = "example.com"
+					}`, instanceID, testHCLString(ca.CertificatePEM), testHCLString(cert.CertificatePEM),
+					testHCLString(cert.PrivateKeyPEM)),
+				Check: resource.TestCheckResourceAttr(customCertificateResourceName, "not_after",
+					cert.Certificate.NotAfter.UTC().Format(time.RFC3339)),
+			},
+		},
+	})
+}
@@ -2,172 +2,360 @@ package cloudamqp
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func resourceCustomDomain() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceCustomDomainCreate,
-		ReadContext:   resourceCustomDomainRead,
-		UpdateContext: resourceCustomDomainUpdate,
-		DeleteContext: resourceCustomDomainDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"instance_id": {
-				Type:        schema.TypeInt,
+var (
+	_ resource.Resource                = &customDomainResource{}
+	_ resource.ResourceWithConfigure   = &customDomainResource{}
+	_ resource.ResourceWithImportState = &customDomainResource{}
+)
+
+var dnsRecordAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"type":  types.StringType,
+	"value": types.StringType,
+}
+
+type customDomainResource struct {
+	client *api.API
+}
+
+func NewCustomDomainResource() resource.Resource {
+	return &customDomainResource{}
+}
+
+type customDomainResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	InstanceID           types.Int64  `tfsdk:"instance_id"`
+	Hostname             types.String `tfsdk:"hostname"`
+	WaitForConfiguration types.Bool   `tfsdk:"wait_for_configuration"`
+	Configured           types.Bool   `tfsdk:"configured"`
+	DnsRecords           types.List   `tfsdk:"dns_records"`
+	Sleep                types.Int64  `tfsdk:"sleep"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+type dnsRecordModel struct {
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (r *customDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_custom_domain"
+}
+
+func (r *customDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configure and manage the custom domain for an instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier for this resource, same as the instance identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
 				Required:    true,
-				ForceNew:    true,
 				Description: "Instance identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
-			"hostname": {
-				Type:        schema.TypeString,
+			"hostname": schema.StringAttribute{
 				Required:    true,
 				Description: "The custom hostname.",
 			},
-			"sleep": {
-				Type:        schema.TypeInt,
+			"wait_for_configuration": schema.BoolAttribute{
 				Optional:    true,
-				Default:     10,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Wait until the custom domain is configured, requires the DNS records to exist",
+			},
+			"configured": schema.BoolAttribute{
+				Computed:    true,
+				Description: "The custom domain is configured and the certificate installed",
+			},
+			"dns_records": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: dnsRecordAttrTypes},
+				Description: "The CNAME record, with name, type and value, required for the custom domain to be configured",
+			},
+			"sleep": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10),
 				Description: "Configurable sleep time in seconds between retries for custom domain configuration",
 			},
-			"timeout": {
-				Type:        schema.TypeInt,
+			"timeout": schema.Int64Attribute{
 				Optional:    true,
-				Default:     1800,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1800),
 				Description: "Configurable timeout time in seconds for custom domain configuration",
 			},
 		},
 	}
 }
 
-func resourceCustomDomainCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	api := meta.(*api.API)
-	instanceID := d.Get("instance_id").(int)
-	hostname := d.Get("hostname").(string)
-	sleep := d.Get("sleep").(int)
-	timeout := d.Get("timeout").(int)
-
-	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
-	defer cancel()
+func (r *customDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
 
-	data, err := api.CreateCustomDomain(timeoutCtx, instanceID, hostname, time.Duration(sleep)*time.Second)
+func (r *customDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Invalid import ID format",
+			fmt.Sprintf("Expected the instance identifier, got: %s", req.ID))
+		return
 	}
 
-	d.SetId(strconv.Itoa(instanceID))
-	d.Set("instance_id", instanceID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_configuration"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sleep"), int64(10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(1800))...)
+}
 
-	for k, v := range data {
-		if validateCustomDomainSchemaAttribute(k) {
-			if err = d.Set(k, v); err != nil {
-				return diag.Errorf("error setting %s for resource %s: %s", k, d.Id(), err)
-			}
-		}
+func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan customDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return diag.Diagnostics{}
-}
 
-func resourceCustomDomainRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	api := meta.(*api.API)
-	instanceID, _ := strconv.Atoi(d.Id())
-	sleep := d.Get("sleep").(int)
-	timeout := d.Get("timeout").(int)
+	var (
+		instanceID = plan.InstanceID.ValueInt64()
+		hostname   = plan.Hostname.ValueString()
+		sleep      = time.Duration(plan.Sleep.ValueInt64()) * time.Second
+		timeout    = time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	data, err := r.client.CreateCustomDomain(timeoutCtx, instanceID, hostname,
+		plan.WaitForConfiguration.ValueBool(), sleep)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create custom domain",
+			fmt.Sprintf("Could not configure custom domain %s, make sure the DNS records exist: %s",
+				hostname, err),
+		)
+		return
+	}
+	if data == nil {
+		resp.Diagnostics.AddError(
+			"Custom domain not found",
+			fmt.Sprintf("Custom domain for instance %d could not be found after being created", instanceID),
+		)
+		return
+	}
 
-	// Set defaults during import
-	if d.Get("instance_id").(int) == 0 {
-		d.Set("instance_id", instanceID)
+	plan.ID = types.StringValue(strconv.FormatInt(instanceID, 10))
+	plan.Configured = types.BoolValue(data.Configured)
+	var diags diag.Diagnostics
+	plan.DnsRecords, diags = r.dnsRecords(timeoutCtx, instanceID, hostname)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if sleep == 0 && timeout == 0 {
-		sleep = 10
-		d.Set("sleep", 10)
-		timeout = 1800
-		d.Set("timeout", 1800)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *customDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state customDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	var (
+		instanceID = state.InstanceID.ValueInt64()
+		sleep      = time.Duration(state.Sleep.ValueInt64()) * time.Second
+		timeout    = time.Duration(state.Timeout.ValueInt64()) * time.Second
+	)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	data, err := api.ReadCustomDomain(timeoutCtx, instanceID, time.Duration(sleep)*time.Second)
+	data, err := r.client.ReadCustomDomain(timeoutCtx, instanceID, sleep)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Failed to read custom domain",
+			fmt.Sprintf("Could not read custom domain for instance %d: %s", instanceID, err),
+		)
+		return
 	}
 
 	// Resource drift: instance or resource not found, trigger re-creation
 	if data == nil {
-		d.SetId("")
-		return nil
+		tflog.Info(ctx, fmt.Sprintf("custom domain not found, resource will be recreated: %d", instanceID))
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	d.SetId(strconv.Itoa(instanceID))
-	d.Set("instance_id", instanceID)
+	// Hostname is not returned until configured, keep the hostname from the state.
+	if data.Hostname != nil {
+		state.Hostname = types.StringValue(*data.Hostname)
+	}
+	state.Configured = types.BoolValue(data.Configured)
 
-	for k, v := range data {
-		if validateCustomDomainSchemaAttribute(k) {
-			if err = d.Set(k, v); err != nil {
-				return diag.Errorf("error setting %s for resource %s: %s", k, d.Id(), err)
-			}
+	// The DNS record only changes with the hostname, read the instance hostname once imported.
+	if state.DnsRecords.IsNull() || !customDomainDnsRecordName(ctx, state.DnsRecords).Equal(state.Hostname) {
+		var diags diag.Diagnostics
+		state.DnsRecords, diags = r.dnsRecords(timeoutCtx, instanceID, state.Hostname.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
-	return diag.Diagnostics{}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceCustomDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	api := meta.(*api.API)
-	instanceID, _ := strconv.Atoi(d.Id())
-	hostname := d.Get("hostname").(string)
-	sleep := d.Get("sleep").(int)
-	timeout := d.Get("timeout").(int)
+func (r *customDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state customDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	// Only sleep, timeout or wait_for_configuration changed
+	if plan.Hostname.Equal(state.Hostname) {
+		plan.Configured = state.Configured
+		plan.DnsRecords = state.DnsRecords
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	var (
+		instanceID = plan.InstanceID.ValueInt64()
+		hostname   = plan.Hostname.ValueString()
+		sleep      = time.Duration(plan.Sleep.ValueInt64()) * time.Second
+		timeout    = time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	data, err := api.UpdateCustomDomain(timeoutCtx, instanceID, hostname, time.Duration(sleep)*time.Second)
+	data, err := r.client.UpdateCustomDomain(timeoutCtx, instanceID, hostname,
+		plan.WaitForConfiguration.ValueBool(), sleep)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Failed to update custom domain",
+			fmt.Sprintf("Could not configure custom domain %s, make sure the DNS records exist: %s",
+				hostname, err),
+		)
+		return
+	}
+	if data == nil {
+		resp.Diagnostics.AddError(
+			"Custom domain not found",
+			fmt.Sprintf("Custom domain for instance %d could not be found after being updated", instanceID),
+		)
+		return
 	}
 
-	d.SetId(strconv.Itoa(instanceID))
-	d.Set("instance_id", instanceID)
-
-	for k, v := range data {
-		if validateCustomDomainSchemaAttribute(k) {
-			if err = d.Set(k, v); err != nil {
-				return diag.Errorf("error setting %s for resource %s: %s", k, d.Id(), err)
-			}
-		}
+	plan.Configured = types.BoolValue(data.Configured)
+	var diags diag.Diagnostics
+	plan.DnsRecords, diags = r.dnsRecords(timeoutCtx, instanceID, hostname)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return diag.Diagnostics{}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceCustomDomainDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	api := meta.(*api.API)
-	instanceID, _ := strconv.Atoi(d.Id())
-	sleep := d.Get("sleep").(int)
-	timeout := d.Get("timeout").(int)
+func (r *customDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state customDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	var (
+		instanceID = state.InstanceID.ValueInt64()
+		sleep      = time.Duration(state.Sleep.ValueInt64()) * time.Second
+		timeout    = time.Duration(state.Timeout.ValueInt64()) * time.Second
+	)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, err := api.DeleteCustomDomain(timeoutCtx, instanceID, time.Duration(sleep)*time.Second)
+	if _, err := r.client.DeleteCustomDomain(timeoutCtx, instanceID, sleep); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete custom domain",
+			fmt.Sprintf("Could not delete custom domain for instance %d: %s", instanceID, err),
+		)
+	}
+}
+
+// dnsRecords: The CNAME record pointing the custom hostname to the external hostname of the
+// instance.
+func (r *customDomainResource) dnsRecords(ctx context.Context, instanceID int64, hostname string) (types.List, diag.Diagnostics) {
+	var (
+		diags     diag.Diagnostics
+		nullValue = types.ListNull(types.ObjectType{AttrTypes: dnsRecordAttrTypes})
+	)
+
+	data, err := r.client.ReadInstance(ctx, strconv.FormatInt(instanceID, 10))
 	if err != nil {
-		return diag.FromErr(err)
+		diags.AddError(
+			"Failed to read instance",
+			fmt.Sprintf("Could not read hostname of instance %d: %s", instanceID, err),
+		)
+		return nullValue, diags
+	}
+	hostnameExternal, _ := data["hostname_external"].(string)
+	if hostnameExternal == "" {
+		diags.AddError(
+			"Instance hostname not found",
+			fmt.Sprintf("Instance %d has no external hostname to point the custom domain to", instanceID),
+		)
+		return nullValue, diags
 	}
 
-	return diag.Diagnostics{}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dnsRecordAttrTypes}, []dnsRecordModel{{
+		Name:  types.StringValue(hostname),
+		Type:  types.StringValue("CNAME"),
+		Value: types.StringValue(hostnameExternal),
+	}})
 }
 
-func validateCustomDomainSchemaAttribute(key string) bool {
-	switch key {
-	case "hostname":
-		return true
+// customDomainDnsRecordName: Name of the DNS record in the state, the hostname it was created for.
+func customDomainDnsRecordName(ctx context.Context, records types.List) types.String {
+	var models []dnsRecordModel
+	if diags := records.ElementsAs(ctx, &models, false); diags.HasError() || len(models) == 0 {
+		return types.StringNull()
 	}
-	return false
+	return models[0].Name
 }
@@ -1,83 +1,112 @@
 package cloudamqp
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
-	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-// Recording the test benefits from having sleep set to default (10 s).
-// While replaying the test, using lower sleep (1 s), will speed up the test.
-
-// TestAccCustomDomain_Basic: Create custom domain, import and update hostname.
+// TestAccCustomDomain_Basic: Create custom domain, import and update hostname, with the CNAME
+// record pointing to the instance hostname.
 func TestAccCustomDomain_Basic(t *testing.T) {
 	t.Parallel()
 
-	var (
-		fileNames                = []string{"instance", "custom_domain"}
-		instanceResourceName     = "cloudamqp_instance.instance"
-		customDomainResourceName = "cloudamqp_custom_domain.custom_domain"
-
-		params = map[string]string{
-			"InstanceName":         "TestAccCustomDomain_Basic",
-			"InstanceID":           fmt.Sprintf("%s.id", instanceResourceName),
-			"InstancePlan":         "bunny-1",
-			"CustomDomainHostname": "vcr-test.ddns.net",
-			"CustomDomainSleep":    "1",
-			"CustomDomainTimeout":  "1800",
-		}
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	data, err := server.API().ReadInstance(context.Background(), fmt.Sprint(instanceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostnameExternal := data["hostname_external"].(string)
 
-		paramsUpdated = map[string]string{
-			"InstanceName":         "TestAccCustomDomain_Basic",
-			"InstanceID":           fmt.Sprintf("%s.id", instanceResourceName),
-			"InstancePlan":         "bunny-1",
-			"CustomDomainHostname": "vcr-update.ddns.net",
-			"CustomDomainSleep":    "1",
-			"CustomDomainTimeout":  "1800",
-		}
-	)
+	customDomainResourceName := "cloudamqp_custom_domain.custom_domain"
+	config := func(hostname string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_custom_domain" "custom_domain" {
+				instance_id = %d
+				hostname    = "%s"
+				sleep       = 1
+			}`, instanceID, hostname)
+	}
 
-	cloudamqpResourceTest(t, resource.TestCase{
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
 		PreCheck: func() { testAccPreCheck(t) },
 		Steps: []resource.TestStep{
 			{
-				Config: configuration.GetTemplatedConfig(t, fileNames, params),
+				Config: config("test.example.com"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr(instanceResourceName, "name", params["InstanceName"]),
-					resource.TestCheckResourceAttr(customDomainResourceName, "hostname", params["CustomDomainHostname"]),
-					resource.TestCheckResourceAttr(customDomainResourceName, "sleep", params["CustomDomainSleep"]),
-					resource.TestCheckResourceAttr(customDomainResourceName, "timeout", params["CustomDomainTimeout"]),
+					resource.TestCheckResourceAttr(customDomainResourceName, "hostname", "test.example.com"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "sleep", "1"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "timeout", "1800"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "configured", "true"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.#", "1"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.0.name", "test.example.com"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.0.type", "CNAME"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.0.value", hostnameExternal),
 				),
 			},
 			{
 				ResourceName:            customDomainResourceName,
-				ImportStateIdFunc:       testAccImportCustomDomainStateIdFunc(instanceResourceName),
+				ImportStateId:           fmt.Sprint(instanceID),
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"sleep", "timeout"},
 			},
 			{
-				Config: configuration.GetTemplatedConfig(t, fileNames, paramsUpdated),
+				Config: config("update.example.com"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr(customDomainResourceName, "hostname", paramsUpdated["CustomDomainHostname"]),
-					resource.TestCheckResourceAttr(customDomainResourceName, "sleep", paramsUpdated["CustomDomainSleep"]),
-					resource.TestCheckResourceAttr(customDomainResourceName, "timeout", paramsUpdated["CustomDomainTimeout"]),
+					resource.TestCheckResourceAttr(customDomainResourceName, "hostname", "update.example.com"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.0.name", "update.example.com"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.0.value", hostnameExternal),
 				),
 			},
 		},
 	})
 }
 
-// testAccImportCustomDomainStateIdFunc returns the instance_id for import.
-func testAccImportCustomDomainStateIdFunc(instanceResourceName string) resource.ImportStateIdFunc {
-	return func(state *terraform.State) (string, error) {
-		rs, ok := state.RootModule().Resources[instanceResourceName]
-		if !ok {
-			return "", fmt.Errorf("Resource %s not found", instanceResourceName)
-		}
-		return rs.Primary.Attributes["id"], nil
-	}
+// TestAccCustomDomain_NoWait: Create custom domain without waiting for it to be configured.
+func TestAccCustomDomain_NoWait(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+
+	// The certificate is still being generated when the custom domain is read after create.
+	server.InjectFault(fakeapi.Fault{
+		Method:     http.MethodGet,
+		Path:       `/custom-domain$`,
+		StatusCode: http.StatusOK,
+		Body:       map[string]any{"hostname": nil, "configured": false},
+		Times:      1,
+	})
+
+	customDomainResourceName := "cloudamqp_custom_domain.custom_domain"
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_custom_domain" "custom_domain" {
+						instance_id            = %d
+						hostname               = "test.example.com"
+						wait_for_configuration = false
+						sleep                  = 1
+					}
+				`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(customDomainResourceName, "hostname", "test.example.com"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "configured", "false"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.0.name", "test.example.com"),
+					resource.TestCheckResourceAttr(customDomainResourceName, "dns_records.0.type", "CNAME"),
+				),
+			},
+		},
+	})
 }
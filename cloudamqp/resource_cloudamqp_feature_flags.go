@@ -0,0 +1,332 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	instancemodel "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &featureFlagsResource{}
+	_ resource.ResourceWithConfigure = &featureFlagsResource{}
+)
+
+type featureFlagsResource struct {
+	client *api.API
+}
+
+func NewFeatureFlagsResource() resource.Resource {
+	return &featureFlagsResource{}
+}
+
+type featureFlagsResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	InstanceID      types.Int64  `tfsdk:"instance_id"`
+	FeatureFlags    types.Set    `tfsdk:"feature_flags"`
+	EnableAllStable types.Bool   `tfsdk:"enable_all_stable"`
+	Flags           types.List   `tfsdk:"flags"`
+	Sleep           types.Int64  `tfsdk:"sleep"`
+	Timeout         types.Int64  `tfsdk:"timeout"`
+}
+
+type featureFlagModel struct {
+	Name      types.String `tfsdk:"name"`
+	State     types.String `tfsdk:"state"`
+	Stability types.String `tfsdk:"stability"`
+}
+
+var featureFlagAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"state":     types.StringType,
+	"stability": types.StringType,
+}
+
+func (r *featureFlagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_feature_flags"
+}
+
+func (r *featureFlagsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable RabbitMQ feature flags on an instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier for this resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The CloudAMQP instance identifier.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"feature_flags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Set of feature flag names to enable.",
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("enable_all_stable")),
+				},
+			},
+			"enable_all_stable": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Enable all stable feature flags, required before upgrading to RabbitMQ 4.x.",
+			},
+			"flags": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: featureFlagAttrTypes},
+				Description: "All feature flags on the instance and their state, with name, state and stability.",
+			},
+			"sleep": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10),
+				Description: "Configurable sleep time in seconds between retries for feature flag operations (default: 10).",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1800),
+				Description: "Configurable timeout time in seconds for feature flag operations (default: 1800).",
+			},
+		},
+	}
+}
+
+func (r *featureFlagsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *featureFlagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan featureFlagsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var enableList []string
+	if !plan.FeatureFlags.IsNull() {
+		resp.Diagnostics.Append(plan.FeatureFlags.ElementsAs(ctx, &enableList, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	request := instancemodel.FeatureFlagsRequest{
+		Enable:          enableList,
+		EnableAllStable: plan.EnableAllStable.ValueBool(),
+	}
+	resp.Diagnostics.Append(r.enable(ctx, &plan, request)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", plan.InstanceID.ValueInt64()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *featureFlagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state featureFlagsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(state.Timeout.ValueInt64())*time.Second)
+	defer cancel()
+
+	flags, err := r.client.ListFeatureFlags(timeoutCtx, state.InstanceID.ValueInt64(),
+		time.Duration(state.Sleep.ValueInt64())*time.Second)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading feature flags", err.Error())
+		return
+	}
+
+	// Resource drift: instance not found, trigger re-creation.
+	if flags == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	enabled := make(map[string]bool, len(flags))
+	allStableEnabled := true
+	for _, flag := range flags {
+		enabled[flag.Name] = flag.State == "enabled"
+		if flag.Stability == "stable" && flag.State != "enabled" {
+			allStableEnabled = false
+		}
+	}
+
+	// Stable feature flags added by an upgrade are disabled, trigger enabling them again.
+	if state.EnableAllStable.ValueBool() && !allStableEnabled {
+		state.EnableAllStable = types.BoolValue(false)
+	}
+
+	// Only keep feature flags in state that are still enabled.
+	if !state.FeatureFlags.IsNull() {
+		var stateFlags []string
+		resp.Diagnostics.Append(state.FeatureFlags.ElementsAs(ctx, &stateFlags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var refreshed []string
+		for _, name := range stateFlags {
+			if enabled[name] {
+				refreshed = append(refreshed, name)
+			}
+		}
+
+		setValue, diags := types.SetValueFrom(ctx, types.StringType, refreshed)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.FeatureFlags = setValue
+	}
+
+	list, diags := featureFlagsListValue(ctx, flags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Flags = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *featureFlagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state featureFlagsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planFlags, stateFlags []string
+	if !plan.FeatureFlags.IsNull() {
+		resp.Diagnostics.Append(plan.FeatureFlags.ElementsAs(ctx, &planFlags, false)...)
+	}
+	if !state.FeatureFlags.IsNull() {
+		resp.Diagnostics.Append(state.FeatureFlags.ElementsAs(ctx, &stateFlags, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Enable: in plan, but not already enabled according to state.
+	stateIndex := make(map[string]bool, len(stateFlags))
+	for _, name := range stateFlags {
+		stateIndex[name] = true
+	}
+	var enableList []string
+	for _, name := range planFlags {
+		if !stateIndex[name] {
+			enableList = append(enableList, name)
+		}
+	}
+
+	request := instancemodel.FeatureFlagsRequest{
+		Enable:          enableList,
+		EnableAllStable: plan.EnableAllStable.ValueBool() && !state.EnableAllStable.ValueBool(),
+	}
+	resp.Diagnostics.Append(r.enable(ctx, &plan, request)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", plan.InstanceID.ValueInt64()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *featureFlagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Feature flags cannot be disabled once enabled, only remove the resource from the state.
+	tflog.Info(ctx, "cloudamqp::resource::feature_flags::delete feature flags cannot be disabled, "+
+		"removing resource from state only.")
+}
+
+// enable sends the enable request (if anything to enable), polls for job completion and
+// refreshes the computed flags list in the model.
+func (r *featureFlagsResource) enable(ctx context.Context, model *featureFlagsResourceModel,
+	request instancemodel.FeatureFlagsRequest) diag.Diagnostics {
+
+	var (
+		diags      diag.Diagnostics
+		instanceID = model.InstanceID.ValueInt64()
+		sleep      = time.Duration(model.Sleep.ValueInt64()) * time.Second
+		timeout    = time.Duration(model.Timeout.ValueInt64()) * time.Second
+	)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if len(request.Enable) > 0 || request.EnableAllStable {
+		jobResp, err := r.client.EnableFeatureFlags(timeoutCtx, instanceID, request)
+		if err != nil {
+			diags.AddError("Error enabling feature flags", err.Error())
+			return diags
+		}
+
+		_, err = r.client.PollForJobCompleted(timeoutCtx, instanceID, *jobResp.ID, sleep)
+		if err != nil {
+			diags.AddError("Error polling for feature flags job", err.Error())
+			return diags
+		}
+	}
+
+	flags, err := r.client.ListFeatureFlags(timeoutCtx, instanceID, sleep)
+	if err != nil {
+		diags.AddError("Error reading feature flags", err.Error())
+		return diags
+	}
+
+	list, listDiags := featureFlagsListValue(ctx, flags)
+	diags.Append(listDiags...)
+	model.Flags = list
+	return diags
+}
+
+// featureFlagsListValue converts the API feature flags into a list of flag objects.
+func featureFlagsListValue(ctx context.Context, flags []instancemodel.FeatureFlagResponse) (
+	types.List, diag.Diagnostics) {
+
+	models := make([]featureFlagModel, len(flags))
+	for i, flag := range flags {
+		models[i] = featureFlagModel{
+			Name:      types.StringValue(flag.Name),
+			State:     types.StringValue(flag.State),
+			Stability: types.StringValue(flag.Stability),
+		}
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: featureFlagAttrTypes}, models)
+}
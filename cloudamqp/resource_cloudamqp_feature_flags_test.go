@@ -0,0 +1,66 @@
+package cloudamqp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccFeatureFlags_Basic: Enable a set of feature flags, then enable all stable feature flags and
+// read them back with the data source.
+func TestAccFeatureFlags_Basic(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+
+	var (
+		featureFlagsResourceName   = "cloudamqp_feature_flags.flags"
+		featureFlagsDataSourceName = "data.cloudamqp_feature_flags.flags"
+	)
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_feature_flags" "flags" {
+						instance_id   = %d
+						feature_flags = ["message_containers"]
+					}
+				`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "feature_flags.#", "1"),
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "flags.#", "5"),
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "flags.2.name", "message_containers"),
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "flags.2.state", "enabled"),
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "flags.3.state", "disabled"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_feature_flags" "flags" {
+						instance_id       = %d
+						enable_all_stable = true
+					}
+
+					data "cloudamqp_feature_flags" "flags" {
+						instance_id = cloudamqp_feature_flags.flags.instance_id
+					}
+				`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "enable_all_stable", "true"),
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "flags.3.state", "enabled"),
+					resource.TestCheckResourceAttr(featureFlagsResourceName, "flags.4.state", "enabled"),
+					resource.TestCheckResourceAttr(featureFlagsDataSourceName, "flags.#", "5"),
+					resource.TestCheckResourceAttr(featureFlagsDataSourceName, "flags.1.name", "khepri_db"),
+					resource.TestCheckResourceAttr(featureFlagsDataSourceName, "flags.1.state", "disabled"),
+					resource.TestCheckResourceAttr(featureFlagsDataSourceName, "flags.4.state", "enabled"),
+				),
+			},
+		},
+	})
+}
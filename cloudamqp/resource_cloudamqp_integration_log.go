@@ -5,13 +5,20 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
 	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/integrations"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,13 +27,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &integrationLogResource{}
-	_ resource.ResourceWithConfigure   = &integrationLogResource{}
-	_ resource.ResourceWithImportState = &integrationLogResource{}
+	_ resource.Resource                   = &integrationLogResource{}
+	_ resource.ResourceWithConfigure      = &integrationLogResource{}
+	_ resource.ResourceWithImportState    = &integrationLogResource{}
+	_ resource.ResourceWithValidateConfig = &integrationLogResource{}
 )
 
 type integrationLogResource struct {
@@ -38,34 +47,99 @@ func NewIntegrationLogResource() resource.Resource {
 }
 
 type integrationLogResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	InstanceID        types.Int64  `tfsdk:"instance_id"`
-	Name              types.String `tfsdk:"name"`
-	Url               types.String `tfsdk:"url"`
-	HostPort          types.String `tfsdk:"host_port"`
-	Token             types.String `tfsdk:"token"`
-	Region            types.String `tfsdk:"region"`
-	AccessKeyID       types.String `tfsdk:"access_key_id"`
-	SecretAccessKey   types.String `tfsdk:"secret_access_key"`
-	ApiKey            types.String `tfsdk:"api_key"`
-	Tags              types.String `tfsdk:"tags"`
-	ProjectID         types.String `tfsdk:"project_id"`
-	PrivateKey        types.String `tfsdk:"private_key"`
-	ClientEmail       types.String `tfsdk:"client_email"`
-	Host              types.String `tfsdk:"host"`
-	SourceType        types.String `tfsdk:"sourcetype"`
-	PrivateKeyID      types.String `tfsdk:"private_key_id"`
-	Credentials       types.String `tfsdk:"credentials"`
-	Endpoint          types.String `tfsdk:"endpoint"`
-	Application       types.String `tfsdk:"application"`
-	Subsystem         types.String `tfsdk:"subsystem"`
-	TenantID          types.String `tfsdk:"tenant_id"`
-	ApplicationID     types.String `tfsdk:"application_id"`
-	ApplicationSecret types.String `tfsdk:"application_secret"`
-	DceURI            types.String `tfsdk:"dce_uri"`
-	Table             types.String `tfsdk:"table"`
-	DcrID             types.String `tfsdk:"dcr_id"`
-	Retention         types.Int64  `tfsdk:"retention"`
+	ID                 types.String `tfsdk:"id"`
+	InstanceID         types.Int64  `tfsdk:"instance_id"`
+	Name               types.String `tfsdk:"name"`
+	Url                types.String `tfsdk:"url"`
+	HostPort           types.String `tfsdk:"host_port"`
+	Token              types.String `tfsdk:"token"`
+	Region             types.String `tfsdk:"region"`
+	AccessKeyID        types.String `tfsdk:"access_key_id"`
+	SecretAccessKey    types.String `tfsdk:"secret_access_key"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	Tags               types.String `tfsdk:"tags"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	ClientEmail        types.String `tfsdk:"client_email"`
+	Host               types.String `tfsdk:"host"`
+	SourceType         types.String `tfsdk:"sourcetype"`
+	PrivateKeyID       types.String `tfsdk:"private_key_id"`
+	Credentials        types.String `tfsdk:"credentials"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	Application        types.String `tfsdk:"application"`
+	Subsystem          types.String `tfsdk:"subsystem"`
+	TenantID           types.String `tfsdk:"tenant_id"`
+	ApplicationID      types.String `tfsdk:"application_id"`
+	ApplicationSecret  types.String `tfsdk:"application_secret"`
+	DceURI             types.String `tfsdk:"dce_uri"`
+	Table              types.String `tfsdk:"table"`
+	DcrID              types.String `tfsdk:"dcr_id"`
+	Retention          types.Int64  `tfsdk:"retention"`
+	Headers            types.Map    `tfsdk:"headers"`
+	Protocol           types.String `tfsdk:"protocol"`
+	ResourceAttributes types.Map    `tfsdk:"resource_attributes"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	Index              types.String `tfsdk:"index"`
+}
+
+// logIntegrationAttributes: Attributes used by an integration type. All attributes are optional in
+// the schema since shared between the integration types, configuring an attribute not used by the
+// type is an error.
+type logIntegrationAttributes struct {
+	Required []string
+	Optional []string
+}
+
+var logIntegrations = map[string]logIntegrationAttributes{
+	"azure_monitor": {
+		Required: []string{"tenant_id", "application_id", "application_secret", "dce_uri", "table", "dcr_id"},
+	},
+	"cloudwatchlog": {
+		Required: []string{"region", "access_key_id", "secret_access_key"},
+		Optional: []string{"retention", "tags"},
+	},
+	"coralogix": {
+		Required: []string{"private_key", "endpoint", "application", "subsystem"},
+	},
+	"datadog": {
+		Required: []string{"region", "api_key"},
+		Optional: []string{"tags"},
+	},
+	"elasticsearch": {
+		Required: []string{"url", "index"},
+		Optional: []string{"api_key", "username", "password"},
+	},
+	"logentries": {
+		Required: []string{"token"},
+	},
+	"loggly": {
+		Required: []string{"token"},
+	},
+	"loki": {
+		Required: []string{"url"},
+		Optional: []string{"username", "password", "tenant_id"},
+	},
+	"opensearch": {
+		Required: []string{"url", "index", "username", "password"},
+	},
+	"opentelemetry": {
+		Required: []string{"endpoint"},
+		Optional: []string{"headers", "protocol", "resource_attributes"},
+	},
+	"papertrail": {
+		Required: []string{"url"},
+	},
+	"scalyr": {
+		Required: []string{"token", "host"},
+	},
+	"splunk": {
+		Required: []string{"host_port", "token", "sourcetype"},
+	},
+	"stackdriver": {
+		// Either credentials or the service account values, validated in ValidateConfig.
+		Optional: []string{"credentials", "project_id", "private_key", "client_email", "private_key_id"},
+	},
 }
 
 func (r *integrationLogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -113,8 +187,12 @@ func (r *integrationLogResource) Schema(ctx context.Context, req resource.Schema
 						"cloudwatchlog",
 						"coralogix",
 						"datadog",
+						"elasticsearch",
 						"logentries",
 						"loggly",
+						"loki",
+						"opensearch",
+						"opentelemetry",
 						"papertrail",
 						"scalyr",
 						"splunk",
@@ -124,7 +202,7 @@ func (r *integrationLogResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"url": schema.StringAttribute{
 				Optional:    true,
-				Description: "The URL to push the logs to. (Papertrail)",
+				Description: "The URL to push the logs to. (Papertrail, Loki, Elasticsearch, OpenSearch)",
 			},
 			"host_port": schema.StringAttribute{
 				Optional:    true,
@@ -137,7 +215,7 @@ func (r *integrationLogResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"region": schema.StringAttribute{
 				Optional:    true,
-				Description: "The region hosting integration service. (Cloudwatch, Datadog)",
+				Description: "The region hosting integration service, the Datadog site for Datadog. (Cloudwatch, Datadog)",
 			},
 			"access_key_id": schema.StringAttribute{
 				Optional:    true,
@@ -152,11 +230,14 @@ func (r *integrationLogResource) Schema(ctx context.Context, req resource.Schema
 			"api_key": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "The API key for the integration service. (Datadog)",
+				Description: "The API key for the integration service. (Datadog, Elasticsearch)",
 			},
 			"tags": schema.StringAttribute{
 				Optional:    true,
-				Description: "Optional tags. E.g. env=prod,region=europe. (Cloudwatch, Datadog)",
+				Description: "Optional tags. E.g. env:prod,region:europe. (Cloudwatch, Datadog)",
+				Validators: []validator.String{
+					validators.TagsValidator{},
+				},
 			},
 			"project_id": schema.StringAttribute{
 				Optional:    true,
@@ -213,7 +294,7 @@ func (r *integrationLogResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"endpoint": schema.StringAttribute{
 				Optional:    true,
-				Description: "The syslog destination to send the logs to. (Papertrail)",
+				Description: "The syslog destination to send the logs to (Coralogix) or the OTLP endpoint URL (OpenTelemetry)",
 			},
 			"application": schema.StringAttribute{
 				Optional:    true,
@@ -225,7 +306,7 @@ func (r *integrationLogResource) Schema(ctx context.Context, req resource.Schema
 			},
 			"tenant_id": schema.StringAttribute{
 				Optional:    true,
-				Description: "The tenant ID. (Azure Monitor)",
+				Description: "The tenant ID, for Loki sent as X-Scope-OrgID header. (Azure Monitor, Loki)",
 			},
 			"application_id": schema.StringAttribute{
 				Optional:    true,
@@ -252,29 +333,155 @@ func (r *integrationLogResource) Schema(ctx context.Context, req resource.Schema
 				Optional:    true,
 				Description: "The number of days to retain logs. (Cloudwatch)",
 			},
+			"headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Headers sent with the exported logs, e.g. for authentication. (OpenTelemetry)",
+			},
+			"protocol": schema.StringAttribute{
+				Optional:    true,
+				Description: "OTLP protocol, grpc or http. Default grpc. (OpenTelemetry)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(otelProtocols...),
+				},
+			},
+			"resource_attributes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Resource attributes added to the exported logs, e.g. service.name. (OpenTelemetry)",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username for basic authentication, for Grafana Cloud the user ID. (Loki, Elasticsearch, OpenSearch)",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for basic authentication, for Grafana Cloud an access policy token. (Loki, Elasticsearch, OpenSearch)",
+			},
+			"index": schema.StringAttribute{
+				Optional:    true,
+				Description: "The index to write the logs to. (Elasticsearch, OpenSearch)",
+			},
 		},
 	}
 }
 
-func (r *integrationLogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	tflog.Info(ctx, fmt.Sprintf("ImportState: ID=%s", req.ID))
-	if !strings.Contains(req.ID, ",") {
-		resp.Diagnostics.AddError("Invalid import ID format", "Expected format: {resource_id},{instance_id}")
+func (r *integrationLogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config integrationLogResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	integration, ok := logIntegrations[name]
+	if config.Name.IsUnknown() || !ok {
+		return
+	}
+
+	var values map[string]tftypes.Value
+	if err := req.Config.Raw.As(&values); err != nil {
+		resp.Diagnostics.AddError("Failed to read configuration", err.Error())
 		return
 	}
+	for _, attribute := range integration.Required {
+		if values[attribute].IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Missing Attribute Configuration",
+				fmt.Sprintf("%s is required for the %s integration", attribute, name))
+		}
+	}
+	for _, attribute := range slices.Sorted(maps.Keys(values)) {
+		switch {
+		case attribute == "id" || attribute == "instance_id" || attribute == "name":
+		case slices.Contains(integration.Required, attribute) || slices.Contains(integration.Optional, attribute):
+		case !values[attribute].IsNull() && values[attribute].IsKnown():
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid Attribute Combination",
+				fmt.Sprintf("%s is not used by the %s integration", attribute, name))
+		}
+	}
 
+	switch name {
+	case "datadog":
+		resp.Diagnostics.Append(validators.ValidateDatadogSite(path.Root("region"), config.Region, true)...)
+	case "elasticsearch", "loki", "opensearch":
+		resp.Diagnostics.Append(validateHTTPURL(path.Root("url"), config.Url)...)
+		if config.Username.IsNull() != config.Password.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("password"), "Invalid Attribute Combination",
+				fmt.Sprintf("username and password must be configured together for the %s integration", name))
+		}
+		if !config.ApiKey.IsNull() && !config.Username.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("api_key"), "Invalid Attribute Combination",
+				"api_key can not be combined with username and password, use one authentication method")
+		}
+	case "opentelemetry":
+		resp.Diagnostics.Append(validateHTTPURL(path.Root("endpoint"), config.Endpoint)...)
+	case "stackdriver":
+		// Credentials or the service account values used before credentials was added.
+		serviceAccount := []string{"project_id", "private_key", "client_email"}
+		if !config.Credentials.IsNull() {
+			for _, attribute := range append(serviceAccount, "private_key_id") {
+				if !values[attribute].IsNull() {
+					resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid Attribute Combination",
+						fmt.Sprintf("%s can not be combined with credentials, the value is read from the credentials", attribute))
+				}
+			}
+			return
+		}
+		for _, attribute := range serviceAccount {
+			if values[attribute].IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(attribute), "Missing Attribute Configuration",
+					fmt.Sprintf("credentials, or %s, are required for the stackdriver integration",
+						strings.Join(serviceAccount, ", ")))
+				return
+			}
+		}
+	}
+}
+
+func (r *integrationLogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("ImportState: ID=%s", req.ID))
 	idSplit := strings.Split(req.ID, ",")
 	if len(idSplit) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID format", "Expected format: {resource_id},{instance_id}")
+		resp.Diagnostics.AddError("Invalid import ID format",
+			"Expected format: {resource_id},{instance_id} or {instance_id},{integration_name}")
+		return
+	}
+
+	if instanceID, err := strconv.Atoi(idSplit[1]); err == nil {
+		resp.State.SetAttribute(ctx, path.Root("id"), idSplit[0])
+		resp.State.SetAttribute(ctx, path.Root("instance_id"), int64(instanceID))
 		return
 	}
-	instanceID, err := strconv.Atoi(idSplit[1])
+
+	// Import with {instance_id},{integration_name}, look up the identifier by listing the integrations
+	instanceID, err := strconv.Atoi(idSplit[0])
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid instance_id in import ID", fmt.Sprintf("Could not convert instance_id to int: %s", err))
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("id"), idSplit[0])
+	data, err := r.client.ListIntegrationLogs(ctx, int64(instanceID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Log Integrations",
+			fmt.Sprintf("Could not list log integrations: %s", err),
+		)
+		return
+	}
+
+	names := make(map[int64]string, len(data))
+	for _, integration := range data {
+		names[integration.ID] = integration.Type
+	}
+	id, err := integrationImportID(idSplit[1], names)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Import Log Integration", err.Error())
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("id"), id)
 	resp.State.SetAttribute(ctx, path.Root("instance_id"), int64(instanceID))
 }
 
@@ -344,8 +551,9 @@ func (r *integrationLogResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *integrationLogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan integrationLogResourceModel
+	var plan, state integrationLogResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -355,6 +563,8 @@ func (r *integrationLogResource) Update(ctx context.Context, req resource.Update
 	id := plan.ID.ValueString()
 	instanceID := plan.InstanceID.ValueInt64()
 	request := r.populateRequest(&plan)
+	request.Headers = otelClearedMap(request.Headers, state.Headers)
+	request.ResourceAttributes = otelClearedMap(request.ResourceAttributes, state.ResourceAttributes)
 
 	err := r.client.UpdateIntegrationLog(timeoutCtx, instanceID, id, request)
 	if err != nil {
@@ -439,11 +649,7 @@ func (r *integrationLogResource) populateResourceModel(resourceModel *integratio
 		} else {
 			resourceModel.Retention = types.Int64Null()
 		}
-		if data.Config.Tags != nil {
-			resourceModel.Tags = types.StringValue(*data.Config.Tags)
-		} else {
-			resourceModel.Tags = types.StringNull()
-		}
+		resourceModel.Tags = integrationTags(resourceModel.Tags, data.Config.Tags)
 	case "coralogix":
 		resourceModel.PrivateKey = types.StringValue(*data.Config.PrivateKey)
 		resourceModel.Endpoint = types.StringValue(*data.Config.Endpoint)
@@ -452,15 +658,27 @@ func (r *integrationLogResource) populateResourceModel(resourceModel *integratio
 	case "datadog":
 		resourceModel.Region = types.StringValue(*data.Config.Region)
 		resourceModel.ApiKey = types.StringValue(*data.Config.APIKey)
-		if data.Config.Tags != nil {
-			resourceModel.Tags = types.StringValue(*data.Config.Tags)
-		} else {
-			resourceModel.Tags = types.StringNull()
-		}
+		resourceModel.Tags = integrationTags(resourceModel.Tags, data.Config.Tags)
+	case "elasticsearch", "opensearch":
+		resourceModel.Url = types.StringPointerValue(data.Config.URL)
+		resourceModel.Index = types.StringPointerValue(data.Config.Index)
+		resourceModel.Username = types.StringPointerValue(data.Config.Username)
+		resourceModel.Password = types.StringPointerValue(data.Config.Password)
+		resourceModel.ApiKey = types.StringPointerValue(data.Config.APIKey)
 	case "logentries":
 		resourceModel.Token = types.StringValue(*data.Config.Token)
 	case "loggly":
 		resourceModel.Token = types.StringValue(*data.Config.Token)
+	case "loki":
+		resourceModel.Url = types.StringPointerValue(data.Config.URL)
+		resourceModel.Username = types.StringPointerValue(data.Config.Username)
+		resourceModel.Password = types.StringPointerValue(data.Config.Password)
+		resourceModel.TenantID = types.StringPointerValue(data.Config.TenantID)
+	case "opentelemetry":
+		resourceModel.Endpoint = types.StringPointerValue(data.Config.Endpoint)
+		resourceModel.Headers = otelStringMap(resourceModel.Headers, data.Config.Headers)
+		resourceModel.Protocol = otelProtocol(resourceModel.Protocol, data.Config.Protocol)
+		resourceModel.ResourceAttributes = otelStringMap(resourceModel.ResourceAttributes, data.Config.ResourceAttributes)
 	case "papertrail":
 		resourceModel.Url = types.StringValue(*data.Config.URL)
 	case "scalyr":
@@ -503,7 +721,7 @@ func (r *integrationLogResource) populateRequest(plan *integrationLogResourceMod
 			request.Retention = plan.Retention.ValueInt64()
 		}
 		if !plan.Tags.IsNull() {
-			request.Tags = plan.Tags.ValueString()
+			request.Tags = utils.NormalizeTags(plan.Tags.ValueString())
 		}
 	case "coralogix":
 		request = model.LogRequest{
@@ -516,7 +734,15 @@ func (r *integrationLogResource) populateRequest(plan *integrationLogResourceMod
 		request = model.LogRequest{
 			Region: plan.Region.ValueString(),
 			APIKey: plan.ApiKey.ValueString(),
-			Tags:   plan.Tags.ValueString(),
+			Tags:   utils.NormalizeTags(plan.Tags.ValueString()),
+		}
+	case "elasticsearch", "opensearch":
+		request = model.LogRequest{
+			URL:      plan.Url.ValueString(),
+			Index:    plan.Index.ValueString(),
+			Username: plan.Username.ValueString(),
+			Password: plan.Password.ValueString(),
+			APIKey:   plan.ApiKey.ValueString(),
 		}
 	case "logentries":
 		request = model.LogRequest{
@@ -526,6 +752,20 @@ func (r *integrationLogResource) populateRequest(plan *integrationLogResourceMod
 		request = model.LogRequest{
 			Token: plan.Token.ValueString(),
 		}
+	case "loki":
+		request = model.LogRequest{
+			URL:      plan.Url.ValueString(),
+			Username: plan.Username.ValueString(),
+			Password: plan.Password.ValueString(),
+			TenantID: plan.TenantID.ValueString(),
+		}
+	case "opentelemetry":
+		request = model.LogRequest{
+			Endpoint:           plan.Endpoint.ValueString(),
+			Headers:            otelRequestMap(plan.Headers),
+			Protocol:           otelRequestProtocol(plan.Protocol),
+			ResourceAttributes: otelRequestMap(plan.ResourceAttributes),
+		}
 	case "papertrail":
 		request = model.LogRequest{
 			URL: plan.Url.ValueString(),
@@ -564,3 +804,129 @@ func (r *integrationLogResource) populateRequest(plan *integrationLogResourceMod
 
 	return request
 }
+
+// integrationImportID: resolves the integration identifier from the integration name, when
+// imported with {instance_id},{integration_name}. Names maps the identifiers of the integrations on
+// the instance to their names.
+func integrationImportID(name string, names map[int64]string) (string, error) {
+	var ids []int64
+	for id, integrationName := range names {
+		if integrationName == name {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s integration found on the instance", name)
+	case 1:
+		return strconv.FormatInt(ids[0], 10), nil
+	default:
+		matches := make([]string, len(ids))
+		for i, id := range ids {
+			matches[i] = strconv.FormatInt(id, 10)
+		}
+		return "", fmt.Errorf("found multiple %s integrations on the instance (%s), import with "+
+			"{resource_id},{instance_id} instead", name, strings.Join(matches, ", "))
+	}
+}
+
+// integrationTags: Tags from the API, keeps the prior value when only whitespace or duplicated tags
+// differ, since these are removed before sent to the API.
+func integrationTags(prior types.String, value *string) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && utils.TagsEqual(prior.ValueString(), *value) {
+		return prior
+	}
+	return types.StringValue(*value)
+}
+
+// OpenTelemetry integrations export over OTLP to the endpoint URL, with gRPC unless configured.
+var (
+	otelProtocols       = []string{"grpc", "http"}
+	otelDefaultProtocol = "grpc"
+)
+
+var httpURLRegexp = regexp.MustCompile(`^https?://[^/\s]+`)
+
+// validateOtelEndpoint: The endpoint is optional for the other integration types, required and
+// validated to be a URL for OpenTelemetry.
+func validateOtelEndpoint(p path.Path, endpoint types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if endpoint.IsNull() {
+		diags.AddAttributeError(p, "Missing Attribute Configuration",
+			"endpoint is required for the opentelemetry integration")
+		return diags
+	}
+	return validateHTTPURL(p, endpoint)
+}
+
+// validateHTTPURL: Validates the value to be an http or https URL, when configured.
+func validateHTTPURL(p path.Path, value types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+	if !httpURLRegexp.MatchString(value.ValueString()) {
+		diags.AddAttributeError(p, "Invalid URL",
+			fmt.Sprintf("%s %q must be an http or https URL, e.g. https://example.com:443",
+				p, value.ValueString()))
+	}
+	return diags
+}
+
+// otelProtocol: Protocol from the API, kept null when not configured and the default is used.
+func otelProtocol(prior types.String, value *string) types.String {
+	if value == nil || (prior.IsNull() && *value == otelDefaultProtocol) {
+		return prior
+	}
+	return types.StringValue(*value)
+}
+
+// otelStringMap: Headers or resource attributes from the API, the prior value is kept when not
+// returned. An empty map is null unless configured as empty.
+func otelStringMap(prior types.Map, value map[string]string) types.Map {
+	if value == nil {
+		return prior
+	}
+	if len(value) == 0 && (prior.IsNull() || len(prior.Elements()) > 0) {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]attr.Value, len(value))
+	for k, v := range value {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func otelRequestProtocol(protocol types.String) string {
+	if protocol.IsNull() || protocol.IsUnknown() {
+		return otelDefaultProtocol
+	}
+	return protocol.ValueString()
+}
+
+func otelRequestMap(m types.Map) *map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+	values := make(map[string]string, len(m.Elements()))
+	for k, v := range m.Elements() {
+		if s, ok := v.(types.String); ok {
+			values[k] = s.ValueString()
+		}
+	}
+	return &values
+}
+
+// otelClearedMap: The API keeps headers or resource attributes omitted from an update, values
+// removed from the configuration are cleared with an empty map.
+func otelClearedMap(request *map[string]string, prior types.Map) *map[string]string {
+	if request == nil && len(prior.Elements()) > 0 {
+		return &map[string]string{}
+	}
+	return request
+}
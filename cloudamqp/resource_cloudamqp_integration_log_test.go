@@ -1,11 +1,19 @@
 package cloudamqp
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
+	frameworkResource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -159,3 +167,536 @@ func TestAccIntegrationLog_Basic(t *testing.T) {
 		},
 	})
 }
+
+// TestAccIntegrationLog_Validation: Datadog site and tags validated during plan.
+func TestAccIntegrationLog_Validation(t *testing.T) {
+	t.Parallel()
+
+	cloudamqpLocalResourceTest(t, http.NotFoundHandler(), resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "cloudamqp_integration_log" "datadog" {
+						instance_id = 1234
+						name        = "datadog"
+						api_key     = "key"
+						region      = "eu2"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Region "eu2" is not a Datadog site`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_log" "datadog" {
+						instance_id = 1234
+						name        = "datadog"
+						api_key     = "key"
+						region      = "eu1"
+						tags        = "env:prod,:web"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`tag ":web" is missing a key`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_log" "opentelemetry" {
+						instance_id = 1234
+						name        = "opentelemetry"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`endpoint is required for the opentelemetry\s+integration`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_log" "opentelemetry" {
+						instance_id = 1234
+						name        = "opentelemetry"
+						endpoint    = "otlp.example.com:4317"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be an http or https URL`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_log" "loki" {
+						instance_id = 1234
+						name        = "loki"
+						username    = "123456"
+					}
+				`,
+				PlanOnly: true,
+				ExpectError: regexp.MustCompile(
+					`(?s)url is required for the loki integration.*username and password must be\s+configured together`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_log" "opensearch" {
+						instance_id = 1234
+						name        = "opensearch"
+						url         = "https://search.example.com"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)index is required.*username is required.*password is required`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_log" "elasticsearch" {
+						instance_id = 1234
+						name        = "elasticsearch"
+						url         = "https://search.example.com"
+						index       = "rabbitmq"
+						api_key     = "key"
+						username    = "elastic"
+						password    = "secret"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`api_key can not be combined with username and password`),
+			},
+			{
+				// Region is only a Datadog site for Datadog
+				Config: `
+					resource "cloudamqp_integration_log" "cloudwatch" {
+						instance_id       = 1234
+						name              = "cloudwatchlog"
+						access_key_id     = "key"
+						secret_access_key = "secret"
+						region            = "eu-north-1"
+						tags              = "Project=A,Environment=Development"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestAccIntegrationLog_OpenTelemetry: Create, update and import OpenTelemetry log integration
+// against the fake API.
+func TestAccIntegrationLog_OpenTelemetry(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	resourceName := "cloudamqp_integration_log.opentelemetry"
+
+	config := func(protocol string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_integration_log" "opentelemetry" {
+				instance_id = %d
+				name        = "opentelemetry"
+				endpoint    = "https://otlp.example.com:4317"
+				headers     = { authorization = "Bearer token" }
+				%s
+				resource_attributes = {
+					"service.name"           = "rabbitmq"
+					"deployment.environment" = "test"
+				}
+			}`, instanceID, protocol)
+	}
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "opentelemetry"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://otlp.example.com:4317"),
+					resource.TestCheckResourceAttr(resourceName, "headers.authorization", "Bearer token"),
+					resource.TestCheckNoResourceAttr(resourceName, "protocol"),
+					resource.TestCheckResourceAttr(resourceName, "resource_attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_attributes.service.name", "rabbitmq"),
+				),
+			},
+			{
+				Config: config(`protocol = "http"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "protocol", "http"),
+				),
+			},
+			{
+				// Removed headers and resource attributes are cleared, not kept by the API.
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_integration_log" "opentelemetry" {
+						instance_id = %d
+						name        = "opentelemetry"
+						endpoint    = "https://otlp.example.com:4317"
+					}`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "headers.%"),
+					resource.TestCheckNoResourceAttr(resourceName, "resource_attributes.%"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccImportCombinedIdFunc(fmt.Sprint(instanceID), resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccIntegrationLog_LokiElasticsearch: Create, update and import Loki, Elasticsearch and
+// OpenSearch log integrations against the fake API.
+func TestAccIntegrationLog_LokiElasticsearch(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+
+	var (
+		lokiResourceName          = "cloudamqp_integration_log.loki"
+		elasticsearchResourceName = "cloudamqp_integration_log.elasticsearch"
+		opensearchResourceName    = "cloudamqp_integration_log.opensearch"
+	)
+
+	config := func(index string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_integration_log" "loki" {
+				instance_id = %[1]d
+				name        = "loki"
+				url         = "https://logs-prod-eu-west-0.grafana.net"
+				username    = "123456"
+				password    = "glc_token"
+			}
+
+			resource "cloudamqp_integration_log" "elasticsearch" {
+				instance_id = %[1]d
+				name        = "elasticsearch"
+				url         = "https://search.example.com:9243"
+				index       = "%[2]s"
+				api_key     = "elastic_api_key"
+			}
+
+			resource "cloudamqp_integration_log" "opensearch" {
+				instance_id = %[1]d
+				name        = "opensearch"
+				url         = "https://search-domain.eu-north-1.es.amazonaws.com"
+				index       = "%[2]s"
+				username    = "admin"
+				password    = "secret"
+			}`, instanceID, index)
+	}
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config("rabbitmq"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(lokiResourceName, "url", "https://logs-prod-eu-west-0.grafana.net"),
+					resource.TestCheckResourceAttr(lokiResourceName, "username", "123456"),
+					resource.TestCheckResourceAttr(lokiResourceName, "password", "glc_token"),
+					resource.TestCheckNoResourceAttr(lokiResourceName, "tenant_id"),
+					resource.TestCheckResourceAttr(elasticsearchResourceName, "index", "rabbitmq"),
+					resource.TestCheckResourceAttr(elasticsearchResourceName, "api_key", "elastic_api_key"),
+					resource.TestCheckNoResourceAttr(elasticsearchResourceName, "username"),
+					resource.TestCheckResourceAttr(opensearchResourceName, "index", "rabbitmq"),
+					resource.TestCheckResourceAttr(opensearchResourceName, "username", "admin"),
+				),
+			},
+			{
+				Config: config("rabbitmq-logs"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(elasticsearchResourceName, "index", "rabbitmq-logs"),
+					resource.TestCheckResourceAttr(opensearchResourceName, "index", "rabbitmq-logs"),
+				),
+			},
+			{
+				ResourceName:      lokiResourceName,
+				ImportStateIdFunc: testAccImportCombinedIdFunc(fmt.Sprint(instanceID), lokiResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      elasticsearchResourceName,
+				ImportStateIdFunc: testAccImportCombinedIdFunc(fmt.Sprint(instanceID), elasticsearchResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      opensearchResourceName,
+				ImportStateIdFunc: testAccImportCombinedIdFunc(fmt.Sprint(instanceID), opensearchResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccIntegrationLog_ImportByName: Import log integration with {instance_id},{integration_name}.
+func TestAccIntegrationLog_ImportByName(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	lokiResourceName := "cloudamqp_integration_log.loki"
+
+	config := fmt.Sprintf(`
+		resource "cloudamqp_integration_log" "loki" {
+			instance_id = %d
+			name        = "loki"
+			url         = "https://logs-prod-eu-west-0.grafana.net"
+		}`, instanceID)
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      lokiResourceName,
+				ImportStateId:     fmt.Sprintf("%d,loki", instanceID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  lokiResourceName,
+				ImportStateId: fmt.Sprintf("%d,splunk", instanceID),
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`no splunk integration found on the instance`),
+			},
+		},
+	})
+}
+
+// TestIntegrationImportID: Resolve the integration identifier from the integration name.
+func TestIntegrationImportID(t *testing.T) {
+	t.Parallel()
+
+	names := map[int64]string{10: "datadog", 3: "loki", 7: "datadog"}
+	tests := []struct {
+		name     string
+		expected string
+		err      string
+	}{
+		{name: "loki", expected: "3"},
+		{name: "splunk", err: "no splunk integration found on the instance"},
+		{name: "datadog", err: "found multiple datadog integrations on the instance (7, 10), import with " +
+			"{resource_id},{instance_id} instead"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := integrationImportID(tc.name, names)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if id != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, id)
+			}
+		})
+	}
+}
+
+// TestIntegrationLogValidateConfig: Required and not used attributes per integration type.
+func TestIntegrationLogValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	tests := []struct {
+		name   string
+		config map[string]any
+		errors []string
+	}{
+		{
+			name: "azure_monitor",
+			config: map[string]any{"name": "azure_monitor", "tenant_id": "tenant", "application_id": "app",
+				"application_secret": "secret", "dce_uri": "https://dce.example.com", "table": "logs", "dcr_id": "dcr"},
+		},
+		{
+			name: "azure_monitor missing table",
+			config: map[string]any{"name": "azure_monitor", "tenant_id": "tenant", "application_id": "app",
+				"application_secret": "secret", "dce_uri": "https://dce.example.com", "dcr_id": "dcr"},
+			errors: []string{"table is required for the azure_monitor integration"},
+		},
+		{
+			name: "cloudwatchlog with optional attributes",
+			config: map[string]any{"name": "cloudwatchlog", "region": "eu-north-1", "access_key_id": "key",
+				"secret_access_key": "secret", "retention": 7, "tags": "Project=A"},
+		},
+		{
+			name: "cloudwatchlog not used attribute",
+			config: map[string]any{"name": "cloudwatchlog", "region": "eu-north-1", "access_key_id": "key",
+				"secret_access_key": "secret", "api_key": "key"},
+			errors: []string{"api_key is not used by the cloudwatchlog integration"},
+		},
+		{
+			name: "coralogix",
+			config: map[string]any{"name": "coralogix", "private_key": "key", "endpoint": "syslog.eu2.coralogix.com:6514",
+				"application": "app", "subsystem": "rabbitmq"},
+		},
+		{
+			name:   "datadog missing api key",
+			config: map[string]any{"name": "datadog", "region": "us1"},
+			errors: []string{"api_key is required for the datadog integration"},
+		},
+		{
+			name:   "datadog invalid site",
+			config: map[string]any{"name": "datadog", "region": "us2", "api_key": "key"},
+			errors: []string{`Region "us2" is not a Datadog site`},
+		},
+		{
+			name:   "elasticsearch api key",
+			config: map[string]any{"name": "elasticsearch", "url": "https://search.example.com", "index": "logs", "api_key": "key"},
+		},
+		{
+			name: "elasticsearch username without password",
+			config: map[string]any{"name": "elasticsearch", "url": "https://search.example.com", "index": "logs",
+				"username": "elastic"},
+			errors: []string{"username and password must be configured together"},
+		},
+		{
+			name:   "logentries",
+			config: map[string]any{"name": "logentries", "token": "token"},
+		},
+		{
+			name:   "loggly not used attribute",
+			config: map[string]any{"name": "loggly", "token": "token", "host": "app.scalyr.com"},
+			errors: []string{"host is not used by the loggly integration"},
+		},
+		{
+			name:   "loki with tenant",
+			config: map[string]any{"name": "loki", "url": "https://loki.example.com", "tenant_id": "team-a"},
+		},
+		{
+			name:   "loki invalid url",
+			config: map[string]any{"name": "loki", "url": "loki.example.com:3100"},
+			errors: []string{"must be an http or https URL"},
+		},
+		{
+			name:   "opensearch missing credentials",
+			config: map[string]any{"name": "opensearch", "url": "https://search.example.com", "index": "logs"},
+			errors: []string{"username is required for the opensearch integration", "password is required for the opensearch integration"},
+		},
+		{
+			name: "opentelemetry",
+			config: map[string]any{"name": "opentelemetry", "endpoint": "https://otlp.example.com:4317",
+				"headers": map[string]string{"authorization": "Bearer token"}, "protocol": "http"},
+		},
+		{
+			name:   "opentelemetry missing endpoint and not used attribute",
+			config: map[string]any{"name": "opentelemetry", "retention": 7},
+			errors: []string{"endpoint is required for the opentelemetry integration", "retention is not used by the opentelemetry integration"},
+		},
+		{
+			name:   "papertrail missing url",
+			config: map[string]any{"name": "papertrail"},
+			errors: []string{"url is required for the papertrail integration"},
+		},
+		{
+			name:   "scalyr",
+			config: map[string]any{"name": "scalyr", "token": "token", "host": "app.scalyr.com"},
+		},
+		{
+			name:   "splunk missing sourcetype",
+			config: map[string]any{"name": "splunk", "host_port": "splunk.example.com:8088", "token": "token"},
+			errors: []string{"sourcetype is required for the splunk integration"},
+		},
+		{
+			name:   "stackdriver credentials",
+			config: map[string]any{"name": "stackdriver", "credentials": "eyJ9"},
+		},
+		{
+			name:   "stackdriver credentials and service account",
+			config: map[string]any{"name": "stackdriver", "credentials": "eyJ9", "project_id": "project"},
+			errors: []string{"project_id can not be combined with credentials"},
+		},
+		{
+			name: "stackdriver service account",
+			config: map[string]any{"name": "stackdriver", "project_id": "project", "private_key": "key",
+				"client_email": "rabbitmq@project.iam.gserviceaccount.com"},
+		},
+		{
+			name:   "stackdriver missing credentials",
+			config: map[string]any{"name": "stackdriver"},
+			errors: []string{"credentials, or project_id, private_key, client_email, are required"},
+		},
+		{
+			name:   "unknown required attribute",
+			config: map[string]any{"name": "logentries", "token": unknown},
+		},
+		{
+			name:   "unknown name",
+			config: map[string]any{"name": unknown, "token": "token", "url": "https://example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx  = context.Background()
+				r    = NewIntegrationLogResource().(frameworkResource.ResourceWithValidateConfig)
+				req  = frameworkResource.ValidateConfigRequest{Config: integrationLogConfig(t, tt.config)}
+				resp frameworkResource.ValidateConfigResponse
+			)
+			r.ValidateConfig(ctx, req, &resp)
+
+			var errors []string
+			for _, d := range resp.Diagnostics.Errors() {
+				errors = append(errors, d.Detail())
+			}
+			if len(errors) != len(tt.errors) {
+				t.Fatalf("expected %d errors, got: %q", len(tt.errors), errors)
+			}
+			for i, expected := range tt.errors {
+				if !strings.Contains(errors[i], expected) {
+					t.Errorf("expected error %q, got: %q", expected, errors[i])
+				}
+			}
+		})
+	}
+}
+
+// integrationLogConfig: Configuration with the values set, the other attributes null.
+func integrationLogConfig(t *testing.T, values map[string]any) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp frameworkResource.SchemaResponse
+	NewIntegrationLogResource().Schema(ctx, frameworkResource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name := range values {
+		if _, ok := objectType.AttributeTypes[name]; !ok {
+			t.Fatalf("unknown attribute: %s", name)
+		}
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		switch value := values[name].(type) {
+		case nil:
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		case tftypes.Value:
+			attributes[name] = value
+		case map[string]string:
+			elements := make(map[string]tftypes.Value, len(value))
+			for k, v := range value {
+				elements[k] = tftypes.NewValue(tftypes.String, v)
+			}
+			attributes[name] = tftypes.NewValue(attributeType, elements)
+		default:
+			attributes[name] = tftypes.NewValue(attributeType, value)
+		}
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
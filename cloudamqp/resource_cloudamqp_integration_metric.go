@@ -11,6 +11,8 @@ import (
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
 	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/integrations"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,9 +26,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &integrationMetricResource{}
-	_ resource.ResourceWithConfigure   = &integrationMetricResource{}
-	_ resource.ResourceWithImportState = &integrationMetricResource{}
+	_ resource.Resource                   = &integrationMetricResource{}
+	_ resource.ResourceWithConfigure      = &integrationMetricResource{}
+	_ resource.ResourceWithImportState    = &integrationMetricResource{}
+	_ resource.ResourceWithValidateConfig = &integrationMetricResource{}
 )
 
 type integrationMetricResource struct {
@@ -38,25 +41,29 @@ func NewIntegrationMetricResource() resource.Resource {
 }
 
 type integrationMetricResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	InstanceID      types.Int64  `tfsdk:"instance_id"`
-	Name            types.String `tfsdk:"name"`
-	AccessKeyID     types.String `tfsdk:"access_key_id"`
-	ApiKey          types.String `tfsdk:"api_key"`
-	ClientEmail     types.String `tfsdk:"client_email"`
-	Credentials     types.String `tfsdk:"credentials"`
-	Email           types.String `tfsdk:"email"`
-	IAMExternalID   types.String `tfsdk:"iam_external_id"`
-	IAMRole         types.String `tfsdk:"iam_role"`
-	IncludeAdQueues types.Bool   `tfsdk:"include_ad_queues"`
-	PrivateKey      types.String `tfsdk:"private_key"`
-	PrivateKeyID    types.String `tfsdk:"private_key_id"`
-	ProjectID       types.String `tfsdk:"project_id"`
-	QueueAllowlist  types.String `tfsdk:"queue_allowlist"`
-	Region          types.String `tfsdk:"region"`
-	Tags            types.String `tfsdk:"tags"`
-	SecretAccessKey types.String `tfsdk:"secret_access_key"`
-	VhostAllowlist  types.String `tfsdk:"vhost_allowlist"`
+	ID                 types.String `tfsdk:"id"`
+	InstanceID         types.Int64  `tfsdk:"instance_id"`
+	Name               types.String `tfsdk:"name"`
+	AccessKeyID        types.String `tfsdk:"access_key_id"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	ClientEmail        types.String `tfsdk:"client_email"`
+	Credentials        types.String `tfsdk:"credentials"`
+	Email              types.String `tfsdk:"email"`
+	IAMExternalID      types.String `tfsdk:"iam_external_id"`
+	IAMRole            types.String `tfsdk:"iam_role"`
+	IncludeAdQueues    types.Bool   `tfsdk:"include_ad_queues"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	PrivateKeyID       types.String `tfsdk:"private_key_id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	QueueAllowlist     types.String `tfsdk:"queue_allowlist"`
+	Region             types.String `tfsdk:"region"`
+	Tags               types.String `tfsdk:"tags"`
+	SecretAccessKey    types.String `tfsdk:"secret_access_key"`
+	VhostAllowlist     types.String `tfsdk:"vhost_allowlist"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	Headers            types.Map    `tfsdk:"headers"`
+	Protocol           types.String `tfsdk:"protocol"`
+	ResourceAttributes types.Map    `tfsdk:"resource_attributes"`
 }
 
 func (r *integrationMetricResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -106,6 +113,7 @@ func (r *integrationMetricResource) Schema(ctx context.Context, req resource.Sch
 						"datadog_v2",
 						"librato",
 						"newrelic_v2",
+						"opentelemetry",
 						"stackdriver",
 					),
 				},
@@ -180,7 +188,7 @@ func (r *integrationMetricResource) Schema(ctx context.Context, req resource.Sch
 			},
 			"region": schema.StringAttribute{
 				Optional:    true,
-				Description: "AWS region for Cloudwatch and [US/EU] for Data dog/New relic. (Cloudwatch, Data Dog, New Relic)",
+				Description: "AWS region for Cloudwatch, Datadog site for Data dog and [US/EU] for New relic. (Cloudwatch, Data Dog, New Relic)",
 			},
 			"secret_access_key": schema.StringAttribute{
 				Optional:    true,
@@ -189,35 +197,98 @@ func (r *integrationMetricResource) Schema(ctx context.Context, req resource.Sch
 			},
 			"tags": schema.StringAttribute{
 				Optional:    true,
-				Description: "(optional) tags. E.g. env=prod,region=europe",
+				Description: "(optional) tags. E.g. env:prod,region:europe",
+				Validators: []validator.String{
+					validators.TagsValidator{},
+				},
 			},
 			"vhost_allowlist": schema.StringAttribute{
 				Optional:    true,
 				Description: "(optional) allowlist using regular expression",
 			},
+			"endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "The OTLP endpoint URL to export metrics to. (OpenTelemetry)",
+			},
+			"headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Headers sent with the exported metrics, e.g. for authentication. (OpenTelemetry)",
+			},
+			"protocol": schema.StringAttribute{
+				Optional:    true,
+				Description: "OTLP protocol, grpc or http. Default grpc. (OpenTelemetry)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(otelProtocols...),
+				},
+			},
+			"resource_attributes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Resource attributes added to the exported metrics, e.g. service.name. (OpenTelemetry)",
+			},
 		},
 	}
 }
 
-func (r *integrationMetricResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	tflog.Info(ctx, fmt.Sprintf("ImportState: ID=%s", req.ID))
-	if !strings.Contains(req.ID, ",") {
-		resp.Diagnostics.AddError("Invalid import ID format", "Expected format: {resource_id},{instance_id}")
+func (r *integrationMetricResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config integrationMetricResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	switch config.Name.ValueString() {
+	case "datadog", "datadog_v2":
+		resp.Diagnostics.Append(validators.ValidateDatadogSite(path.Root("region"), config.Region, true)...)
+	case "opentelemetry":
+		resp.Diagnostics.Append(validateOtelEndpoint(path.Root("endpoint"), config.Endpoint)...)
+	}
+}
+
+func (r *integrationMetricResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("ImportState: ID=%s", req.ID))
 	idSplit := strings.Split(req.ID, ",")
 	if len(idSplit) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID format", "Expected format: {resource_id},{instance_id}")
+		resp.Diagnostics.AddError("Invalid import ID format",
+			"Expected format: {resource_id},{instance_id} or {instance_id},{integration_name}")
 		return
 	}
-	instanceID, err := strconv.Atoi(idSplit[1])
+
+	if instanceID, err := strconv.Atoi(idSplit[1]); err == nil {
+		resp.State.SetAttribute(ctx, path.Root("id"), idSplit[0])
+		resp.State.SetAttribute(ctx, path.Root("instance_id"), int64(instanceID))
+		return
+	}
+
+	// Import with {instance_id},{integration_name}, look up the identifier by listing the integrations
+	instanceID, err := strconv.Atoi(idSplit[0])
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid instance_id in import ID", fmt.Sprintf("Could not convert instance_id to int: %s", err))
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("id"), idSplit[0])
+	data, err := r.client.ListIntegrationMetrics(ctx, int64(instanceID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Metric Integrations",
+			fmt.Sprintf("Could not list metric integrations: %s", err),
+		)
+		return
+	}
+
+	names := make(map[int64]string, len(data))
+	for _, integration := range data {
+		names[integration.ID] = integration.Type
+	}
+	id, err := integrationImportID(idSplit[1], names)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Import Metric Integration", err.Error())
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("id"), id)
 	resp.State.SetAttribute(ctx, path.Root("instance_id"), int64(instanceID))
 }
 
@@ -288,8 +359,9 @@ func (r *integrationMetricResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *integrationMetricResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan integrationMetricResourceModel
+	var plan, state integrationMetricResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -299,6 +371,8 @@ func (r *integrationMetricResource) Update(ctx context.Context, req resource.Upd
 	instanceID := plan.InstanceID.ValueInt64()
 	metricID := plan.ID.ValueString()
 	request := r.populateRequest(&plan)
+	request.Headers = otelClearedMap(request.Headers, state.Headers)
+	request.ResourceAttributes = otelClearedMap(request.ResourceAttributes, state.ResourceAttributes)
 
 	err := r.client.UpdateIntegrationMetric(timeoutCtx, instanceID, metricID, request)
 	if err != nil {
@@ -385,6 +459,11 @@ func (r *integrationMetricResource) populateResourceModel(resourceModel *integra
 	case "newrelic_v2":
 		resourceModel.ApiKey = types.StringValue(*data.Config.APIKey)
 		resourceModel.Region = types.StringValue(*data.Config.Region)
+	case "opentelemetry":
+		resourceModel.Endpoint = types.StringPointerValue(data.Config.Endpoint)
+		resourceModel.Headers = otelStringMap(resourceModel.Headers, data.Config.Headers)
+		resourceModel.Protocol = otelProtocol(resourceModel.Protocol, data.Config.Protocol)
+		resourceModel.ResourceAttributes = otelStringMap(resourceModel.ResourceAttributes, data.Config.ResourceAttributes)
 	case "stackdriver":
 		if resourceModel.Credentials.ValueString() == "" {
 			resourceModel.ClientEmail = types.StringValue(*data.Config.ClientEmail)
@@ -409,11 +488,7 @@ func (r *integrationMetricResource) populateResourceModel(resourceModel *integra
 	} else {
 		resourceModel.VhostAllowlist = types.StringNull()
 	}
-	if data.Config.Tags != nil {
-		resourceModel.Tags = types.StringValue(*data.Config.Tags)
-	} else {
-		resourceModel.Tags = types.StringNull()
-	}
+	resourceModel.Tags = integrationTags(resourceModel.Tags, data.Config.Tags)
 }
 
 // Handle data conversion from resource model to API request
@@ -444,6 +519,11 @@ func (r *integrationMetricResource) populateRequest(plan *integrationMetricResou
 	case "newrelic_v2":
 		request.APIKey = plan.ApiKey.ValueString()
 		request.Region = plan.Region.ValueString()
+	case "opentelemetry":
+		request.Endpoint = plan.Endpoint.ValueString()
+		request.Headers = otelRequestMap(plan.Headers)
+		request.Protocol = otelRequestProtocol(plan.Protocol)
+		request.ResourceAttributes = otelRequestMap(plan.ResourceAttributes)
 	case "stackdriver":
 		if plan.Credentials.ValueString() != "" {
 			uDec, _ := base64.URLEncoding.DecodeString(plan.Credentials.ValueString())
@@ -469,7 +549,7 @@ func (r *integrationMetricResource) populateRequest(plan *integrationMetricResou
 		request.QueueRegex = plan.QueueAllowlist.ValueString()
 	}
 	if !plan.Tags.IsUnknown() {
-		request.Tags = plan.Tags.ValueString()
+		request.Tags = utils.NormalizeTags(plan.Tags.ValueString())
 	}
 	if !plan.VhostAllowlist.IsUnknown() {
 		request.VhostRegex = plan.VhostAllowlist.ValueString()
@@ -5,107 +5,258 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/integrations"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceIntegrationMetricPrometheus() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceIntegrationMetricPrometheusCreate,
-		ReadContext:   resourceIntegrationMetricPrometheusRead,
-		UpdateContext: resourceIntegrationMetricPrometheusUpdate,
-		DeleteContext: resourceIntegrationMetricPrometheusDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+var (
+	_ resource.Resource                     = &integrationMetricPrometheusResource{}
+	_ resource.ResourceWithConfigure        = &integrationMetricPrometheusResource{}
+	_ resource.ResourceWithConfigValidators = &integrationMetricPrometheusResource{}
+	_ resource.ResourceWithImportState      = &integrationMetricPrometheusResource{}
+	_ resource.ResourceWithModifyPlan       = &integrationMetricPrometheusResource{}
+	_ resource.ResourceWithUpgradeState     = &integrationMetricPrometheusResource{}
+)
+
+// prometheusIntegrations: integration blocks, named after the integration type.
+var prometheusIntegrations = []string{
+	"newrelic_v3",
+	"datadog_v3",
+	"azure_monitor",
+	"splunk_v2",
+	"dynatrace",
+	"cloudwatch_v3",
+	"stackdriver_v2",
+	"opentelemetry",
+}
+
+var metricsFilterRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+type integrationMetricPrometheusResource struct {
+	client *api.API
+}
+
+func NewIntegrationMetricPrometheusResource() resource.Resource {
+	return &integrationMetricPrometheusResource{}
+}
+
+type integrationMetricPrometheusResourceModel struct {
+	ID            types.String                   `tfsdk:"id"`
+	InstanceID    types.Int64                    `tfsdk:"instance_id"`
+	MetricsFilter types.List                     `tfsdk:"metrics_filter"`
+	NewRelicV3    []prometheusNewRelicV3Model    `tfsdk:"newrelic_v3"`
+	DatadogV3     []prometheusDatadogV3Model     `tfsdk:"datadog_v3"`
+	AzureMonitor  []prometheusAzureMonitorModel  `tfsdk:"azure_monitor"`
+	SplunkV2      []prometheusSplunkV2Model      `tfsdk:"splunk_v2"`
+	Dynatrace     []prometheusDynatraceModel     `tfsdk:"dynatrace"`
+	CloudwatchV3  []prometheusCloudwatchV3Model  `tfsdk:"cloudwatch_v3"`
+	StackdriverV2 []prometheusStackdriverV2Model `tfsdk:"stackdriver_v2"`
+	OpenTelemetry []prometheusOpenTelemetryModel `tfsdk:"opentelemetry"`
+}
+
+// integrationMetricPrometheusResourceModelV0: State stored by the SDKv2 resource, before the
+// OpenTelemetry block was added.
+type integrationMetricPrometheusResourceModelV0 struct {
+	ID            types.String                   `tfsdk:"id"`
+	InstanceID    types.Int64                    `tfsdk:"instance_id"`
+	MetricsFilter types.List                     `tfsdk:"metrics_filter"`
+	NewRelicV3    []prometheusNewRelicV3Model    `tfsdk:"newrelic_v3"`
+	DatadogV3     []prometheusDatadogV3Model     `tfsdk:"datadog_v3"`
+	AzureMonitor  []prometheusAzureMonitorModel  `tfsdk:"azure_monitor"`
+	SplunkV2      []prometheusSplunkV2Model      `tfsdk:"splunk_v2"`
+	Dynatrace     []prometheusDynatraceModel     `tfsdk:"dynatrace"`
+	CloudwatchV3  []prometheusCloudwatchV3Model  `tfsdk:"cloudwatch_v3"`
+	StackdriverV2 []prometheusStackdriverV2Model `tfsdk:"stackdriver_v2"`
+}
+
+type prometheusNewRelicV3Model struct {
+	APIKey types.String `tfsdk:"api_key"`
+	Region types.String `tfsdk:"region"`
+	Tags   types.String `tfsdk:"tags"`
+}
+
+type prometheusDatadogV3Model struct {
+	APIKey                         types.String `tfsdk:"api_key"`
+	Region                         types.String `tfsdk:"region"`
+	Tags                           types.String `tfsdk:"tags"`
+	RabbitMQDashboardMetricsFormat types.Bool   `tfsdk:"rabbitmq_dashboard_metrics_format"`
+}
+
+type prometheusAzureMonitorModel struct {
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+type prometheusSplunkV2Model struct {
+	Token    types.String `tfsdk:"token"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	Tags     types.String `tfsdk:"tags"`
+}
+
+type prometheusDynatraceModel struct {
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	AccessToken   types.String `tfsdk:"access_token"`
+	Tags          types.String `tfsdk:"tags"`
+}
+
+type prometheusCloudwatchV3Model struct {
+	IAMRole       types.String `tfsdk:"iam_role"`
+	IAMExternalID types.String `tfsdk:"iam_external_id"`
+	Region        types.String `tfsdk:"region"`
+	Tags          types.String `tfsdk:"tags"`
+}
+
+type prometheusStackdriverV2Model struct {
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	ClientEmail     types.String `tfsdk:"client_email"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+	PrivateKeyID    types.String `tfsdk:"private_key_id"`
+	Tags            types.String `tfsdk:"tags"`
+}
+
+type prometheusOpenTelemetryModel struct {
+	Endpoint           types.String `tfsdk:"endpoint"`
+	Headers            types.Map    `tfsdk:"headers"`
+	Protocol           types.String `tfsdk:"protocol"`
+	ResourceAttributes types.Map    `tfsdk:"resource_attributes"`
+}
+
+func (r *integrationMetricPrometheusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_integration_metric_prometheus"
+}
+
+func (r *integrationMetricPrometheusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *integrationMetricPrometheusResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tagsAttribute := schema.StringAttribute{
+		Optional:    true,
+		Description: "tags. E.g. env:prod,service:web",
+		Validators: []validator.String{
+			validators.TagsValidator{},
 		},
-		Schema: map[string]*schema.Schema{
-			"instance_id": {
-				Type:        schema.TypeInt,
+	}
+
+	resp.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource ID of the prometheus metric integration",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
 				Required:    true,
-				ForceNew:    true,
 				Description: "Instance identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
-			"metrics_filter": {
-				Type:        schema.TypeList,
+			"metrics_filter": schema.ListAttribute{
+				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
 				Description: "List of metrics to include. If not specified, default metrics are used. See https://www.cloudamqp.com/docs/monitoring_metrics_splunk_v2.html#metrics-filtering for more information",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(metricsFilterRegexp,
+							"must be a metric name with lowercase letters, digits and underscores, e.g. rabbitmq_connections"),
+					),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"newrelic_v3": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"datadog_v3", "azure_monitor", "splunk_v2", "dynatrace", "cloudwatch_v3", "stackdriver_v2"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"api_key": {
-							Type:      schema.TypeString,
+		},
+		Blocks: map[string]schema.Block{
+			"newrelic_v3": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_key": schema.StringAttribute{
 							Required:  true,
 							Sensitive: true,
 						},
-						"region": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "New Relic region; eu or us",
-							ValidateFunc: validation.StringInSlice([]string{"eu", "us"}, true),
-						},
-						"tags": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "tags. E.g. env=prod,service=web",
+						"region": schema.StringAttribute{
+							Required:    true,
+							Description: "New Relic region; eu or us",
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive("eu", "us"),
+							},
 						},
+						"tags": tagsAttribute,
 					},
 				},
 			},
-			"datadog_v3": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"newrelic_v3", "azure_monitor", "splunk_v2", "dynatrace", "cloudwatch_v3", "stackdriver_v2"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"api_key": {
-							Type:      schema.TypeString,
+			"datadog_v3": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_key": schema.StringAttribute{
 							Required:  true,
 							Sensitive: true,
 						},
-						"region": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "Datadog region; us1, us3, us5, eu1, or ap2",
-							ValidateFunc: validation.StringInSlice([]string{"us1", "us3", "us5", "eu1", "ap2"}, true),
-						},
-						"tags": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "tags. E.g. env=prod,service=web",
+						"region": schema.StringAttribute{
+							Required:    true,
+							Description: "Datadog site; " + strings.Join(validators.DatadogSites, ", "),
+							Validators: []validator.String{
+								validators.DatadogSiteValidator{},
+							},
 						},
-						"rabbitmq_dashboard_metrics_format": {
-							Type:        schema.TypeBool,
+						"tags": tagsAttribute,
+						"rabbitmq_dashboard_metrics_format": schema.BoolAttribute{
 							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
 							Description: "Enable metric name transformation to match Datadog's RabbitMQ dashboard format",
 						},
 					},
 				},
 			},
-			"azure_monitor": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"newrelic_v3", "datadog_v3", "splunk_v2", "dynatrace", "cloudwatch_v3", "stackdriver_v2"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"connection_string": {
-							Type:        schema.TypeString,
+			"azure_monitor": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_string": schema.StringAttribute{
 							Required:    true,
 							Sensitive:   true,
 							Description: "Azure Application Insights Connection String",
@@ -113,147 +264,125 @@ func resourceIntegrationMetricPrometheus() *schema.Resource {
 					},
 				},
 			},
-			"splunk_v2": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"newrelic_v3", "datadog_v3", "azure_monitor", "dynatrace", "cloudwatch_v3", "stackdriver_v2"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"token": {
-							Type:        schema.TypeString,
+			"splunk_v2": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
 							Required:    true,
 							Sensitive:   true,
 							Description: "Splunk HEC token",
 						},
-						"endpoint": {
-							Type:        schema.TypeString,
+						"endpoint": schema.StringAttribute{
 							Required:    true,
 							Description: "Splunk HEC endpoint. E.g. https://your-instance-id.splunkcloud.com:8088/services/collector",
 						},
-						"tags": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "tags. E.g. env=prod,service=web",
-						},
+						"tags": tagsAttribute,
 					},
 				},
 			},
-			"dynatrace": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"newrelic_v3", "datadog_v3", "azure_monitor", "splunk_v2", "cloudwatch_v3", "stackdriver_v2"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"environment_id": {
-							Type:        schema.TypeString,
+			"dynatrace": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"environment_id": schema.StringAttribute{
 							Required:    true,
 							Description: "Dynatrace environment ID",
 						},
-						"access_token": {
-							Type:        schema.TypeString,
+						"access_token": schema.StringAttribute{
 							Required:    true,
 							Sensitive:   true,
 							Description: "Dynatrace access token with 'Ingest metrics' permission",
 						},
-						"tags": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "tags. E.g. env=prod,service=web",
-						},
+						"tags": tagsAttribute,
 					},
 				},
 			},
-			"cloudwatch_v3": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"newrelic_v3", "datadog_v3", "azure_monitor", "splunk_v2", "dynatrace", "stackdriver_v2"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"iam_role": {
-							Type:        schema.TypeString,
+			"cloudwatch_v3": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"iam_role": schema.StringAttribute{
 							Required:    true,
 							Description: "AWS IAM role ARN with PutMetricData permission",
 						},
-						"iam_external_id": {
-							Type:        schema.TypeString,
+						"iam_external_id": schema.StringAttribute{
 							Required:    true,
 							Description: "External identifier that matches the role you created.",
 						},
-						"region": {
-							Type:        schema.TypeString,
+						"region": schema.StringAttribute{
 							Required:    true,
 							Description: "AWS region",
 						},
-						"tags": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "tags. E.g. env=prod,service=web",
-						},
+						"tags": tagsAttribute,
 					},
 				},
 			},
-			"stackdriver_v2": {
-				Type:          schema.TypeList,
-				Optional:      true,
-				MaxItems:      1,
-				ConflictsWith: []string{"newrelic_v3", "datadog_v3", "azure_monitor", "splunk_v2", "dynatrace", "cloudwatch_v3"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"credentials_file": {
-							Type:        schema.TypeString,
+			"stackdriver_v2": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"credentials_file": schema.StringAttribute{
 							Required:    true,
 							Sensitive:   true,
 							Description: "Base64-encoded Google service account key JSON file",
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// Only suppress for existing resources
-								if d.Id() == "" {
-									return false
-								}
-
-								if stackdriver := d.Get("stackdriver_v2").([]any); len(stackdriver) > 0 {
-									config := stackdriver[0].(map[string]any)
-									newCredentials, err := extractStackdriverCredentials(new)
-									if err != nil {
-										return false
-									}
-									// Suppress diff if new credentials match current state
-									return newCredentials["project_id"] == config["project_id"] &&
-										newCredentials["client_email"] == config["client_email"] &&
-										newCredentials["private_key_id"] == config["private_key_id"] &&
-										newCredentials["private_key"] == config["private_key"]
-								}
-								return false
+							Validators: []validator.String{
+								stackdriverCredentialsValidator{},
 							},
 						},
-						"project_id": {
-							Type:        schema.TypeString,
+						"project_id": schema.StringAttribute{
 							Computed:    true,
 							Description: "Google Cloud project ID (computed from credentials file)",
 						},
-						"client_email": {
-							Type:        schema.TypeString,
+						"client_email": schema.StringAttribute{
 							Computed:    true,
 							Description: "Google service account client email (computed from credentials file)",
 						},
-						"private_key": {
-							Type:        schema.TypeString,
+						"private_key": schema.StringAttribute{
 							Computed:    true,
 							Sensitive:   true,
 							Description: "Google service account private key (computed from credentials file)",
 						},
-						"private_key_id": {
-							Type:        schema.TypeString,
+						"private_key_id": schema.StringAttribute{
 							Computed:    true,
 							Sensitive:   true,
 							Description: "Google service account private key ID (computed from credentials file)",
 						},
-						"tags": {
-							Type:        schema.TypeString,
+						"tags": tagsAttribute,
+					},
+				},
+			},
+			"opentelemetry": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Required:    true,
+							Description: "OTLP endpoint URL. E.g. https://otlp.example.com:4317",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(httpURLRegexp,
+									"must be an http or https URL, e.g. https://otlp.example.com:4317"),
+							},
+						},
+						"headers": schema.MapAttribute{
+							ElementType: types.StringType,
 							Optional:    true,
-							Description: "tags. E.g. env=prod,service=web",
+							Sensitive:   true,
+							Description: "Headers sent with the exported metrics, e.g. for authentication",
+						},
+						"protocol": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(otelDefaultProtocol),
+							Description: "OTLP protocol; grpc or http",
+							Validators: []validator.String{
+								stringvalidator.OneOf(otelProtocols...),
+							},
+						},
+						"resource_attributes": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource attributes added to the exported metrics, e.g. service.name",
 						},
 					},
 				},
@@ -262,385 +391,589 @@ func resourceIntegrationMetricPrometheus() *schema.Resource {
 	}
 }
 
-func resourceIntegrationMetricPrometheusCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var (
-		api     = meta.(*api.API)
-		intName string
-		params  = make(map[string]any)
-	)
+func (r *integrationMetricPrometheusResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.ExactlyOneBlock{Blocks: prometheusIntegrations},
+	}
+}
 
-	if newrelicList := d.Get("newrelic_v3").(*schema.Set).List(); len(newrelicList) > 0 {
-		intName = "newrelic_v3"
-		newrelicConfig := newrelicList[0].(map[string]any)
-		params["api_key"] = newrelicConfig["api_key"]
-		if region := newrelicConfig["region"]; region != nil && region != "" {
-			params["region"] = region
-		}
-		if tags := newrelicConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if datadogList := d.Get("datadog_v3").(*schema.Set).List(); len(datadogList) > 0 {
-		intName = "datadog_v3"
-		datadogConfig := datadogList[0].(map[string]any)
-		params["api_key"] = datadogConfig["api_key"]
-		if region := datadogConfig["region"]; region != nil && region != "" {
-			params["region"] = region
-		}
-		if tags := datadogConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-		if format, ok := datadogConfig["rabbitmq_dashboard_metrics_format"].(bool); ok {
-			if format {
-				params["rabbitmq_dashboard_metrics_format"] = "true"
-			} else {
-				params["rabbitmq_dashboard_metrics_format"] = "false"
-			}
-		}
-	} else if azureMonitorList := d.Get("azure_monitor").(*schema.Set).List(); len(azureMonitorList) > 0 {
-		intName = "azure_monitor"
-		azureMonitorConfig := azureMonitorList[0].(map[string]any)
-		params["connection_string"] = azureMonitorConfig["connection_string"]
-	} else if splunkList := d.Get("splunk_v2").(*schema.Set).List(); len(splunkList) > 0 {
-		intName = "splunk_v2"
-		splunkConfig := splunkList[0].(map[string]any)
-		params["token"] = splunkConfig["token"]
-		params["endpoint"] = splunkConfig["endpoint"]
-		if tags := splunkConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if dynatraceList := d.Get("dynatrace").(*schema.Set).List(); len(dynatraceList) > 0 {
-		intName = "dynatrace"
-		dynatraceConfig := dynatraceList[0].(map[string]any)
-		params["environment_id"] = dynatraceConfig["environment_id"]
-		params["access_token"] = dynatraceConfig["access_token"]
-		if tags := dynatraceConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if cloudwatchList := d.Get("cloudwatch_v3").(*schema.Set).List(); len(cloudwatchList) > 0 {
-		intName = "cloudwatch_v3"
-		cloudwatchConfig := cloudwatchList[0].(map[string]any)
-		params["iam_role"] = cloudwatchConfig["iam_role"]
-		params["iam_external_id"] = cloudwatchConfig["iam_external_id"]
-		params["region"] = cloudwatchConfig["region"]
-		if tags := cloudwatchConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if stackdriverList := d.Get("stackdriver_v2").([]any); len(stackdriverList) > 0 {
-		intName = "stackdriver_v2"
-		stackdriverConfig := stackdriverList[0].(map[string]any)
-		credentials := stackdriverConfig["credentials_file"].(string)
+// UpgradeState: Version 0 is the SDKv2 schema, with set blocks and empty strings for optional values
+// not configured.
+func (r *integrationMetricPrometheusResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: integrationMetricPrometheusSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState integrationMetricPrometheusResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				state := integrationMetricPrometheusResourceModel{
+					ID:            priorState.ID,
+					InstanceID:    priorState.InstanceID,
+					MetricsFilter: priorState.MetricsFilter,
+					NewRelicV3:    priorState.NewRelicV3,
+					DatadogV3:     priorState.DatadogV3,
+					AzureMonitor:  priorState.AzureMonitor,
+					SplunkV2:      priorState.SplunkV2,
+					Dynatrace:     priorState.Dynatrace,
+					CloudwatchV3:  priorState.CloudwatchV3,
+					StackdriverV2: priorState.StackdriverV2,
+					OpenTelemetry: []prometheusOpenTelemetryModel{},
+				}
+				upgradePrometheusStateV0(&state)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			},
+		},
+	}
+}
 
-		extractedCredentials, err := extractStackdriverCredentials(credentials)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+func (r *integrationMetricPrometheusResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-		for key, value := range extractedCredentials {
-			params[key] = value
-		}
+	var plan integrationMetricPrometheusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || len(plan.StackdriverV2) == 0 {
+		return
+	}
 
-		if tags := stackdriverConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
+	// Service account values are computed from the credentials file, known before apply.
+	stackdriver := &plan.StackdriverV2[0]
+	if stackdriver.CredentialsFile.IsUnknown() {
+		stackdriver.ProjectID = types.StringUnknown()
+		stackdriver.ClientEmail = types.StringUnknown()
+		stackdriver.PrivateKey = types.StringUnknown()
+		stackdriver.PrivateKeyID = types.StringUnknown()
+	} else {
+		credentials, err := extractStackdriverCredentials(stackdriver.CredentialsFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("stackdriver_v2").AtListIndex(0).AtName("credentials_file"),
+				"Invalid credentials file", err.Error())
+			return
+		}
+		stackdriver.ProjectID = types.StringValue(credentials["project_id"])
+		stackdriver.ClientEmail = types.StringValue(credentials["client_email"])
+		stackdriver.PrivateKey = types.StringValue(credentials["private_key"])
+		stackdriver.PrivateKeyID = types.StringValue(credentials["private_key_id"])
 	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
 
-	if intName == "" {
-		return diag.Errorf("no integration configuration provided")
+func (r *integrationMetricPrometheusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("ImportState: ID=%s", req.ID))
+	idSplit := strings.Split(req.ID, ",")
+	if len(idSplit) != 2 {
+		resp.Diagnostics.AddError("Invalid import ID format", "Expected format: {resource_id},{instance_id}")
+		return
+	}
+	instanceID, err := strconv.ParseInt(idSplit[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid instance_id in import ID", fmt.Sprintf("Could not convert instance_id to int: %s", err))
+		return
 	}
 
-	if metricsFilter := d.Get("metrics_filter").([]any); len(metricsFilter) > 0 {
-		filters := make([]string, len(metricsFilter))
-		for i, v := range metricsFilter {
-			filters[i] = v.(string)
-		}
-		params["metrics_filter"] = filters
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idSplit[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+}
+
+func (r *integrationMetricPrometheusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan integrationMetricPrometheusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	data, err := api.CreateIntegration(ctx, d.Get("instance_id").(int), "metrics", intName, params)
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	instanceID := plan.InstanceID.ValueInt64()
+	intName, request := r.populateRequest(ctx, &plan)
+
+	id, err := r.client.CreateIntegrationMetric(timeoutCtx, instanceID, intName, request)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	if data["id"] != nil {
-		d.SetId(data["id"].(string))
+		resp.Diagnostics.AddError(
+			"Failed to Create Prometheus Metric Integration",
+			fmt.Sprintf("Could not create prometheus metric integration: %s", err),
+		)
+		return
 	}
 
-	return resourceIntegrationMetricPrometheusRead(ctx, d, meta)
+	plan.ID = types.StringValue(id)
+	resp.Diagnostics.Append(r.read(timeoutCtx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func extractStackdriverCredentials(credentials string) (map[string]string, error) {
-	decoded, err := base64.StdEncoding.DecodeString(credentials)
+func (r *integrationMetricPrometheusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state integrationMetricPrometheusResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	data, err := r.client.ReadIntegrationMetric(timeoutCtx, state.InstanceID.ValueInt64(), state.ID.ValueString())
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode stackdriver credentials: %s", err)
+		resp.Diagnostics.AddError(
+			"Failed to Read Prometheus Metric Integration",
+			fmt.Sprintf("Could not read prometheus metric integration: %s", err),
+		)
+		return
 	}
 
-	var jsonMap map[string]any
-	if err := json.Unmarshal(decoded, &jsonMap); err != nil {
-		return nil, fmt.Errorf("failed to parse stackdriver credentials JSON: %s", err)
+	// Resource drift: instance or resource not found, trigger re-creation
+	if data == nil {
+		tflog.Info(ctx, fmt.Sprintf("prometheus metric integration not found, resource will be recreated: %s", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	requiredFields := []string{"type", "client_email", "private_key_id", "private_key", "project_id"}
-	for _, field := range requiredFields {
-		if jsonMap[field] == nil || jsonMap[field] == "" {
-			return nil, fmt.Errorf("required field '%s' is missing from credentials JSON", field)
+	resp.Diagnostics.Append(r.populateResourceModel(ctx, &state, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *integrationMetricPrometheusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state integrationMetricPrometheusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	intName, request := r.populateRequest(ctx, &plan)
+	stateIntName, stateRequest := r.populateRequest(ctx, &state)
+
+	// Changing the integration type is not supported by the API, the same integration with new
+	// credentials file, e.g. after upgrading the state, results in no update.
+	if intName != stateIntName || !reflect.DeepEqual(request, stateRequest) {
+		if len(plan.OpenTelemetry) > 0 && len(state.OpenTelemetry) > 0 {
+			request.Headers = otelClearedMap(request.Headers, state.OpenTelemetry[0].Headers)
+			request.ResourceAttributes = otelClearedMap(request.ResourceAttributes, state.OpenTelemetry[0].ResourceAttributes)
+		}
+		err := r.client.UpdateIntegrationMetric(timeoutCtx, plan.InstanceID.ValueInt64(), plan.ID.ValueString(), request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Update Prometheus Metric Integration",
+				fmt.Sprintf("Could not update prometheus metric integration: %s", err),
+			)
+			return
 		}
 	}
 
-	return map[string]string{
-		"type":           jsonMap["type"].(string),
-		"client_email":   jsonMap["client_email"].(string),
-		"private_key_id": jsonMap["private_key_id"].(string),
-		"private_key":    jsonMap["private_key"].(string),
-		"project_id":     jsonMap["project_id"].(string),
-	}, nil
+	resp.Diagnostics.Append(r.read(timeoutCtx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceIntegrationMetricPrometheusRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	if strings.Contains(d.Id(), ",") {
-		tflog.Info(ctx, fmt.Sprintf("import resource with identifier: %s", d.Id()))
-		s := strings.Split(d.Id(), ",")
-		d.SetId(s[0])
-		instanceID, _ := strconv.Atoi(s[1])
-		d.Set("instance_id", instanceID)
-	}
-	if d.Get("instance_id").(int) == 0 {
-		return diag.Errorf("missing instance identifier: {resource_id},{instance_id}")
+func (r *integrationMetricPrometheusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state integrationMetricPrometheusResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	api := meta.(*api.API)
-	data, err := api.ReadIntegration(ctx, d.Get("instance_id").(int), "metrics", d.Id())
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
 
+	err := r.client.DeleteIntegrationMetric(timeoutCtx, state.InstanceID.ValueInt64(), state.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Failed to Delete Prometheus Metric Integration",
+			fmt.Sprintf("Could not delete prometheus metric integration: %s", err),
+		)
+		return
 	}
+}
 
-	// Resource drift: instance or resource not found, trigger re-creation
+// read: Reads the integration after create or update, to get values set by the API, e.g. default metrics filter.
+func (r *integrationMetricPrometheusResource) read(ctx context.Context, resourceModel *integrationMetricPrometheusResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data, err := r.client.ReadIntegrationMetric(ctx, resourceModel.InstanceID.ValueInt64(), resourceModel.ID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Failed to Read Prometheus Metric Integration",
+			fmt.Sprintf("Could not read prometheus metric integration: %s", err),
+		)
+		return diags
+	}
 	if data == nil {
-		tflog.Info(ctx, fmt.Sprintf("prometheus metric integration not found, resource will be recreated: %s", d.Id()))
-		d.SetId("")
-		return nil
-	}
-
-	d.Set("newrelic_v3", nil)
-	d.Set("datadog_v3", nil)
-	d.Set("azure_monitor", nil)
-	d.Set("splunk_v2", nil)
-	d.Set("dynatrace", nil)
-	d.Set("cloudwatch_v3", nil)
-	d.Set("stackdriver_v2", nil)
-
-	if metricsFilter, ok := data["metrics_filter"]; ok && metricsFilter != nil {
-		if filterSlice, ok := metricsFilter.([]any); ok {
-			d.Set("metrics_filter", filterSlice)
-		}
+		diags.AddError(
+			"Failed to Read Prometheus Metric Integration",
+			fmt.Sprintf("Prometheus metric integration not found: %s", resourceModel.ID.ValueString()),
+		)
+		return diags
 	}
+	return r.populateResourceModel(ctx, resourceModel, data)
+}
 
-	name := strings.ToLower(data["type"].(string))
-	if name == "newrelic_v3" {
-		newRelicV3 := []map[string]any{{}}
-		if _, ok := data["api_key"]; ok {
-			newRelicV3[0]["api_key"] = data["api_key"]
-		}
-		if region, ok := data["region"]; ok {
-			newRelicV3[0]["region"] = region
-		}
-		if tags, ok := data["tags"]; ok {
-			newRelicV3[0]["tags"] = tags
-		}
-		if err := d.Set("newrelic_v3", newRelicV3); err != nil {
-			return diag.Errorf("error setting newrelic_v3 for resource %s: %s", d.Id(), err)
-		}
-	} else if name == "datadog_v3" {
-		datadogV3 := []map[string]any{{}}
-		if _, ok := data["api_key"]; ok {
-			datadogV3[0]["api_key"] = data["api_key"]
-		}
-		if region, ok := data["region"]; ok {
-			datadogV3[0]["region"] = region
-		}
-		if tags, ok := data["tags"]; ok {
-			datadogV3[0]["tags"] = tags
-		}
-		if format, ok := data["rabbitmq_dashboard_metrics_format"]; ok {
-			datadogV3[0]["rabbitmq_dashboard_metrics_format"] = format == "true"
-		}
-		if err := d.Set("datadog_v3", datadogV3); err != nil {
-			return diag.Errorf("error setting datadog_v3 for resource %s: %s", d.Id(), err)
-		}
-	} else if name == "azure_monitor" {
-		azureMonitor := []map[string]any{{}}
-		if _, ok := data["connection_string"]; ok {
-			azureMonitor[0]["connection_string"] = data["connection_string"]
-		}
-		if err := d.Set("azure_monitor", azureMonitor); err != nil {
-			return diag.Errorf("error setting azure_monitor for resource %s: %s", d.Id(), err)
-		}
-	} else if name == "splunk_v2" {
-		splunkV2 := []map[string]any{{}}
-		if _, ok := data["token"]; ok {
-			splunkV2[0]["token"] = data["token"]
-		}
-		if _, ok := data["endpoint"]; ok {
-			splunkV2[0]["endpoint"] = data["endpoint"]
-		}
-		if tags, ok := data["tags"]; ok {
-			splunkV2[0]["tags"] = tags
-		}
-		if err := d.Set("splunk_v2", splunkV2); err != nil {
-			return diag.Errorf("error setting splunk_v2 for resource %s: %s", d.Id(), err)
-		}
-	} else if name == "dynatrace" {
-		dynatrace := []map[string]any{{}}
-		if _, ok := data["environment_id"]; ok {
-			dynatrace[0]["environment_id"] = data["environment_id"]
-		}
-		if _, ok := data["access_token"]; ok {
-			dynatrace[0]["access_token"] = data["access_token"]
-		}
-		if tags, ok := data["tags"]; ok {
-			dynatrace[0]["tags"] = tags
-		}
-		if err := d.Set("dynatrace", dynatrace); err != nil {
-			return diag.Errorf("error setting dynatrace for resource %s: %s", d.Id(), err)
-		}
-	} else if name == "cloudwatch_v3" {
-		cloudwatchV3 := []map[string]any{{}}
-		if _, ok := data["iam_role"]; ok {
-			cloudwatchV3[0]["iam_role"] = data["iam_role"]
-		}
-		if _, ok := data["iam_external_id"]; ok {
-			cloudwatchV3[0]["iam_external_id"] = data["iam_external_id"]
-		}
-		if _, ok := data["region"]; ok {
-			cloudwatchV3[0]["region"] = data["region"]
-		}
-		if tags, ok := data["tags"]; ok {
-			cloudwatchV3[0]["tags"] = tags
-		}
-		if err := d.Set("cloudwatch_v3", cloudwatchV3); err != nil {
-			return diag.Errorf("error setting cloudwatch_v3 for resource %s: %s", d.Id(), err)
-		}
-	} else if name == "stackdriver_v2" {
-		stackdriverV2 := []map[string]any{{}}
+// Handle data conversion from API response to resource model
+func (r *integrationMetricPrometheusResource) populateResourceModel(ctx context.Context, resourceModel *integrationMetricPrometheusResourceModel,
+	data *model.MetricResponse) diag.Diagnostics {
 
-		if project_id, ok := data["project_id"]; ok {
-			stackdriverV2[0]["project_id"] = project_id
-		}
-		if client_email, ok := data["client_email"]; ok {
-			stackdriverV2[0]["client_email"] = client_email
-		}
-		if private_key, ok := data["private_key"]; ok {
-			stackdriverV2[0]["private_key"] = private_key
-		}
-		if private_key_id, ok := data["private_key_id"]; ok {
-			stackdriverV2[0]["private_key_id"] = private_key_id
-		}
-		if tags, ok := data["tags"]; ok {
-			stackdriverV2[0]["tags"] = tags
-		}
+	var (
+		diags  diag.Diagnostics
+		prior  = *resourceModel
+		config = data.Config
+	)
+	if config == nil {
+		config = &model.MetricConfigResponse{}
+	}
 
-		if err := d.Set("stackdriver_v2", stackdriverV2); err != nil {
-			return diag.Errorf("error setting stackdriver_v2 for resource %s: %s", d.Id(), err)
-		}
+	resourceModel.NewRelicV3 = []prometheusNewRelicV3Model{}
+	resourceModel.DatadogV3 = []prometheusDatadogV3Model{}
+	resourceModel.AzureMonitor = []prometheusAzureMonitorModel{}
+	resourceModel.SplunkV2 = []prometheusSplunkV2Model{}
+	resourceModel.Dynatrace = []prometheusDynatraceModel{}
+	resourceModel.CloudwatchV3 = []prometheusCloudwatchV3Model{}
+	resourceModel.StackdriverV2 = []prometheusStackdriverV2Model{}
+	resourceModel.OpenTelemetry = []prometheusOpenTelemetryModel{}
+
+	switch strings.ToLower(data.Type) {
+	case "newrelic_v3":
+		var block prometheusNewRelicV3Model
+		if len(prior.NewRelicV3) > 0 {
+			block = prior.NewRelicV3[0]
+		}
+		resourceModel.NewRelicV3 = []prometheusNewRelicV3Model{{
+			APIKey: prometheusString(block.APIKey, config.APIKey),
+			Region: prometheusRegion(block.Region, config.Region),
+			Tags:   prometheusTags(block.Tags, config.Tags),
+		}}
+	case "datadog_v3":
+		var block prometheusDatadogV3Model
+		if len(prior.DatadogV3) > 0 {
+			block = prior.DatadogV3[0]
+		}
+		format := false
+		if config.RabbitMQDashboardMetricsFormat != nil {
+			format = *config.RabbitMQDashboardMetricsFormat == "true"
+		}
+		resourceModel.DatadogV3 = []prometheusDatadogV3Model{{
+			APIKey:                         prometheusString(block.APIKey, config.APIKey),
+			Region:                         prometheusRegion(block.Region, config.Region),
+			Tags:                           prometheusTags(block.Tags, config.Tags),
+			RabbitMQDashboardMetricsFormat: types.BoolValue(format),
+		}}
+	case "azure_monitor":
+		var block prometheusAzureMonitorModel
+		if len(prior.AzureMonitor) > 0 {
+			block = prior.AzureMonitor[0]
+		}
+		resourceModel.AzureMonitor = []prometheusAzureMonitorModel{{
+			ConnectionString: prometheusString(block.ConnectionString, config.ConnectionString),
+		}}
+	case "splunk_v2":
+		var block prometheusSplunkV2Model
+		if len(prior.SplunkV2) > 0 {
+			block = prior.SplunkV2[0]
+		}
+		resourceModel.SplunkV2 = []prometheusSplunkV2Model{{
+			Token:    prometheusString(block.Token, config.Token),
+			Endpoint: prometheusString(block.Endpoint, config.Endpoint),
+			Tags:     prometheusTags(block.Tags, config.Tags),
+		}}
+	case "dynatrace":
+		var block prometheusDynatraceModel
+		if len(prior.Dynatrace) > 0 {
+			block = prior.Dynatrace[0]
+		}
+		resourceModel.Dynatrace = []prometheusDynatraceModel{{
+			EnvironmentID: prometheusString(block.EnvironmentID, config.EnvironmentID),
+			AccessToken:   prometheusString(block.AccessToken, config.AccessToken),
+			Tags:          prometheusTags(block.Tags, config.Tags),
+		}}
+	case "cloudwatch_v3":
+		var block prometheusCloudwatchV3Model
+		if len(prior.CloudwatchV3) > 0 {
+			block = prior.CloudwatchV3[0]
+		}
+		resourceModel.CloudwatchV3 = []prometheusCloudwatchV3Model{{
+			IAMRole:       prometheusString(block.IAMRole, config.IAMRole),
+			IAMExternalID: prometheusString(block.IAMExternalID, config.IAMExternalID),
+			Region:        prometheusString(block.Region, config.Region),
+			Tags:          prometheusTags(block.Tags, config.Tags),
+		}}
+	case "stackdriver_v2":
+		// Credentials file not returned by the API, kept from the state and null when imported.
+		block := prometheusStackdriverV2Model{CredentialsFile: types.StringNull()}
+		if len(prior.StackdriverV2) > 0 {
+			block = prior.StackdriverV2[0]
+		}
+		resourceModel.StackdriverV2 = []prometheusStackdriverV2Model{{
+			CredentialsFile: block.CredentialsFile,
+			ProjectID:       prometheusString(block.ProjectID, config.ProjectID),
+			ClientEmail:     prometheusString(block.ClientEmail, config.ClientEmail),
+			PrivateKey:      prometheusString(block.PrivateKey, config.PrivateKey),
+			PrivateKeyID:    prometheusString(block.PrivateKeyID, config.PrivateKeyID),
+			Tags:            prometheusTags(block.Tags, config.Tags),
+		}}
+	case "opentelemetry":
+		block := prometheusOpenTelemetryModel{
+			Headers:            types.MapNull(types.StringType),
+			ResourceAttributes: types.MapNull(types.StringType),
+		}
+		if len(prior.OpenTelemetry) > 0 {
+			block = prior.OpenTelemetry[0]
+		}
+		protocol := types.StringValue(otelDefaultProtocol)
+		if config.Protocol != nil {
+			protocol = types.StringValue(*config.Protocol)
+		}
+		resourceModel.OpenTelemetry = []prometheusOpenTelemetryModel{{
+			Endpoint:           prometheusString(block.Endpoint, config.Endpoint),
+			Headers:            otelStringMap(block.Headers, config.Headers),
+			Protocol:           protocol,
+			ResourceAttributes: otelStringMap(block.ResourceAttributes, config.ResourceAttributes),
+		}}
+	default:
+		diags.AddError("Unsupported Prometheus Metric Integration",
+			fmt.Sprintf("Integration type %s is not supported by this resource", data.Type))
+		return diags
 	}
 
-	return nil
+	if data.MetricsFilter != nil {
+		metricsFilter, d := types.ListValueFrom(ctx, types.StringType, data.MetricsFilter)
+		diags.Append(d...)
+		resourceModel.MetricsFilter = metricsFilter
+	} else if resourceModel.MetricsFilter.IsUnknown() {
+		resourceModel.MetricsFilter = types.ListNull(types.StringType)
+	}
+	return diags
 }
 
-func resourceIntegrationMetricPrometheusUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+// Handle data conversion from resource model to API request, returns the integration type and the request.
+func (r *integrationMetricPrometheusResource) populateRequest(ctx context.Context, plan *integrationMetricPrometheusResourceModel) (string, model.MetricRequest) {
 	var (
-		api    = meta.(*api.API)
-		params = make(map[string]any)
+		intName string
+		request model.MetricRequest
 	)
 
-	if newrelicList := d.Get("newrelic_v3").(*schema.Set).List(); len(newrelicList) > 0 {
-		newrelicConfig := newrelicList[0].(map[string]any)
-		params["api_key"] = newrelicConfig["api_key"]
-		if region := newrelicConfig["region"]; region != nil && region != "" {
-			params["region"] = region
-		}
-		if tags := newrelicConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if datadogList := d.Get("datadog_v3").(*schema.Set).List(); len(datadogList) > 0 {
-		datadogConfig := datadogList[0].(map[string]any)
-		params["api_key"] = datadogConfig["api_key"]
-		if region := datadogConfig["region"]; region != nil && region != "" {
-			params["region"] = region
-		}
-		if tags := datadogConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-		if format, ok := datadogConfig["rabbitmq_dashboard_metrics_format"].(bool); ok {
-			if format {
-				params["rabbitmq_dashboard_metrics_format"] = "true"
-			} else {
-				params["rabbitmq_dashboard_metrics_format"] = "false"
-			}
-		}
-	} else if azureMonitorList := d.Get("azure_monitor").(*schema.Set).List(); len(azureMonitorList) > 0 {
-		azureMonitorConfig := azureMonitorList[0].(map[string]any)
-		params["connection_string"] = azureMonitorConfig["connection_string"]
-	} else if splunkList := d.Get("splunk_v2").(*schema.Set).List(); len(splunkList) > 0 {
-		splunkConfig := splunkList[0].(map[string]any)
-		params["token"] = splunkConfig["token"]
-		params["endpoint"] = splunkConfig["endpoint"]
-		if tags := splunkConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if dynatraceList := d.Get("dynatrace").(*schema.Set).List(); len(dynatraceList) > 0 {
-		dynatraceConfig := dynatraceList[0].(map[string]any)
-		params["environment_id"] = dynatraceConfig["environment_id"]
-		params["access_token"] = dynatraceConfig["access_token"]
-		if tags := dynatraceConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if cloudwatchList := d.Get("cloudwatch_v3").(*schema.Set).List(); len(cloudwatchList) > 0 {
-		cloudwatchConfig := cloudwatchList[0].(map[string]any)
-		params["iam_role"] = cloudwatchConfig["iam_role"]
-		params["iam_external_id"] = cloudwatchConfig["iam_external_id"]
-		params["region"] = cloudwatchConfig["region"]
-		if tags := cloudwatchConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
-		}
-	} else if stackdriverList := d.Get("stackdriver_v2").([]interface{}); len(stackdriverList) > 0 {
-		stackdriverConfig := stackdriverList[0].(map[string]any)
+	switch {
+	case len(plan.NewRelicV3) > 0:
+		intName = "newrelic_v3"
+		block := plan.NewRelicV3[0]
+		request.APIKey = block.APIKey.ValueString()
+		request.Region = block.Region.ValueString()
+		request.Tags = utils.NormalizeTags(block.Tags.ValueString())
+	case len(plan.DatadogV3) > 0:
+		intName = "datadog_v3"
+		block := plan.DatadogV3[0]
+		request.APIKey = block.APIKey.ValueString()
+		request.Region = block.Region.ValueString()
+		request.Tags = utils.NormalizeTags(block.Tags.ValueString())
+		request.RabbitMQDashboardMetricsFormat = strconv.FormatBool(block.RabbitMQDashboardMetricsFormat.ValueBool())
+	case len(plan.AzureMonitor) > 0:
+		intName = "azure_monitor"
+		request.ConnectionString = plan.AzureMonitor[0].ConnectionString.ValueString()
+	case len(plan.SplunkV2) > 0:
+		intName = "splunk_v2"
+		block := plan.SplunkV2[0]
+		request.Token = block.Token.ValueString()
+		request.Endpoint = block.Endpoint.ValueString()
+		request.Tags = utils.NormalizeTags(block.Tags.ValueString())
+	case len(plan.Dynatrace) > 0:
+		intName = "dynatrace"
+		block := plan.Dynatrace[0]
+		request.EnvironmentID = block.EnvironmentID.ValueString()
+		request.AccessToken = block.AccessToken.ValueString()
+		request.Tags = utils.NormalizeTags(block.Tags.ValueString())
+	case len(plan.CloudwatchV3) > 0:
+		intName = "cloudwatch_v3"
+		block := plan.CloudwatchV3[0]
+		request.IAMRole = block.IAMRole.ValueString()
+		request.IAMExternalID = block.IAMExternalID.ValueString()
+		request.Region = block.Region.ValueString()
+		request.Tags = utils.NormalizeTags(block.Tags.ValueString())
+	case len(plan.StackdriverV2) > 0:
+		// Service account values computed from the credentials file in ModifyPlan, the key type
+		// validated to be present is always service_account.
+		intName = "stackdriver_v2"
+		block := plan.StackdriverV2[0]
+		request.Type = "service_account"
+		request.ProjectID = block.ProjectID.ValueString()
+		request.ClientEmail = block.ClientEmail.ValueString()
+		request.PrivateKey = block.PrivateKey.ValueString()
+		request.PrivateKeyID = block.PrivateKeyID.ValueString()
+		request.Tags = utils.NormalizeTags(block.Tags.ValueString())
+	case len(plan.OpenTelemetry) > 0:
+		intName = "opentelemetry"
+		block := plan.OpenTelemetry[0]
+		request.Endpoint = block.Endpoint.ValueString()
+		request.Headers = otelRequestMap(block.Headers)
+		request.Protocol = otelRequestProtocol(block.Protocol)
+		request.ResourceAttributes = otelRequestMap(block.ResourceAttributes)
+	}
 
-		credentials := stackdriverConfig["credentials_file"].(string)
-		extractedCreds, err := extractStackdriverCredentials(credentials)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if !plan.MetricsFilter.IsNull() && !plan.MetricsFilter.IsUnknown() {
+		plan.MetricsFilter.ElementsAs(ctx, &request.MetricsFilter, false)
+	}
+	return intName, request
+}
 
-		for key, value := range extractedCreds {
-			params[key] = value
+// prometheusString: Value from the API, null when missing. An empty prior value is kept to match the configuration.
+func prometheusString(prior types.String, value *string) types.String {
+	if value == nil || *value == "" {
+		if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+			return prior
 		}
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// prometheusTags: Tags from the API, keeps the prior value when equal once normalized.
+func prometheusTags(prior types.String, value *string) types.String {
+	if value != nil && *value != "" && !prior.IsNull() && !prior.IsUnknown() &&
+		utils.TagsEqual(prior.ValueString(), *value) {
+		return prior
+	}
+	return prometheusString(prior, value)
+}
+
+// prometheusRegion: Region from the API, keeps the prior value when only the case differs since the
+// region is validated case insensitive.
+func prometheusRegion(prior types.String, value *string) types.String {
+	if value != nil && strings.EqualFold(prior.ValueString(), *value) {
+		return prior
+	}
+	return prometheusString(prior, value)
+}
 
-		if tags := stackdriverConfig["tags"]; tags != nil && tags != "" {
-			params["tags"] = tags
+// upgradePrometheusStateV0: Optional values not configured are stored as empty strings by SDKv2,
+// the credentials file is not kept after reading the integration.
+func upgradePrometheusStateV0(state *integrationMetricPrometheusResourceModel) {
+	for i := range state.NewRelicV3 {
+		state.NewRelicV3[i].Tags = emptyStringToNull(state.NewRelicV3[i].Tags)
+	}
+	for i := range state.DatadogV3 {
+		state.DatadogV3[i].Tags = emptyStringToNull(state.DatadogV3[i].Tags)
+		if state.DatadogV3[i].RabbitMQDashboardMetricsFormat.IsNull() {
+			state.DatadogV3[i].RabbitMQDashboardMetricsFormat = types.BoolValue(false)
 		}
 	}
+	for i := range state.SplunkV2 {
+		state.SplunkV2[i].Tags = emptyStringToNull(state.SplunkV2[i].Tags)
+	}
+	for i := range state.Dynatrace {
+		state.Dynatrace[i].Tags = emptyStringToNull(state.Dynatrace[i].Tags)
+	}
+	for i := range state.CloudwatchV3 {
+		state.CloudwatchV3[i].Tags = emptyStringToNull(state.CloudwatchV3[i].Tags)
+	}
+	for i := range state.StackdriverV2 {
+		state.StackdriverV2[i].Tags = emptyStringToNull(state.StackdriverV2[i].Tags)
+		state.StackdriverV2[i].CredentialsFile = emptyStringToNull(state.StackdriverV2[i].CredentialsFile)
+	}
+}
+
+func emptyStringToNull(value types.String) types.String {
+	if value.ValueString() == "" {
+		return types.StringNull()
+	}
+	return value
+}
 
-	if d.HasChange("metrics_filter") {
-		metricsFilter := d.Get("metrics_filter").([]any)
-		filters := make([]string, len(metricsFilter))
-		for i, v := range metricsFilter {
-			filters[i] = v.(string)
+// integrationMetricPrometheusSchemaV0: SDKv2 schema, only used to read the prior state.
+func integrationMetricPrometheusSchemaV0() *schema.Schema {
+	stringAttributes := func(names ...string) map[string]schema.Attribute {
+		attributes := make(map[string]schema.Attribute, len(names))
+		for _, name := range names {
+			attributes[name] = schema.StringAttribute{Optional: true}
+		}
+		return attributes
+	}
+	setBlock := func(attributes map[string]schema.Attribute) schema.Block {
+		return schema.SetNestedBlock{
+			Validators:   []validator.Set{setvalidator.SizeAtMost(1)},
+			NestedObject: schema.NestedBlockObject{Attributes: attributes},
 		}
-		params["metrics_filter"] = filters
 	}
 
-	err := api.UpdateIntegration(ctx, d.Get("instance_id").(int), "metrics", d.Id(), params)
+	datadog := stringAttributes("api_key", "region", "tags")
+	datadog["rabbitmq_dashboard_metrics_format"] = schema.BoolAttribute{Optional: true}
+
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":             schema.StringAttribute{Computed: true},
+			"instance_id":    schema.Int64Attribute{Required: true},
+			"metrics_filter": schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"newrelic_v3":   setBlock(stringAttributes("api_key", "region", "tags")),
+			"datadog_v3":    setBlock(datadog),
+			"azure_monitor": setBlock(stringAttributes("connection_string")),
+			"splunk_v2":     setBlock(stringAttributes("token", "endpoint", "tags")),
+			"dynatrace":     setBlock(stringAttributes("environment_id", "access_token", "tags")),
+			"cloudwatch_v3": setBlock(stringAttributes("iam_role", "iam_external_id", "region", "tags")),
+			"stackdriver_v2": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: stringAttributes("credentials_file", "project_id", "client_email",
+						"private_key", "private_key_id", "tags"),
+				},
+			},
+		},
+	}
+}
+
+func extractStackdriverCredentials(credentials string) (map[string]string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(credentials)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, fmt.Errorf("failed to decode stackdriver credentials: %s", err)
+	}
+
+	var jsonMap map[string]any
+	if err := json.Unmarshal(decoded, &jsonMap); err != nil {
+		return nil, fmt.Errorf("failed to parse stackdriver credentials JSON: %s", err)
+	}
+
+	requiredFields := []string{"type", "client_email", "private_key_id", "private_key", "project_id"}
+	for _, field := range requiredFields {
+		if value, ok := jsonMap[field].(string); !ok || value == "" {
+			return nil, fmt.Errorf("required field '%s' is missing from credentials JSON", field)
+		}
 	}
 
-	return resourceIntegrationMetricPrometheusRead(ctx, d, meta)
+	return map[string]string{
+		"type":           jsonMap["type"].(string),
+		"client_email":   jsonMap["client_email"].(string),
+		"private_key_id": jsonMap["private_key_id"].(string),
+		"private_key":    jsonMap["private_key"].(string),
+		"project_id":     jsonMap["project_id"].(string),
+	}, nil
+}
+
+// stackdriverCredentialsValidator: Validates the base64 encoded service account key JSON file.
+type stackdriverCredentialsValidator struct{}
+
+func (v stackdriverCredentialsValidator) Description(ctx context.Context) string {
+	return "Must be a base64 encoded Google service account key JSON file"
+}
+
+func (v stackdriverCredentialsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
 }
 
-func resourceIntegrationMetricPrometheusDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	api := meta.(*api.API)
-	if err := api.DeleteIntegration(ctx, d.Get("instance_id").(int), "metrics", d.Id()); err != nil {
-		return diag.FromErr(err)
+func (v stackdriverCredentialsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := extractStackdriverCredentials(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid credentials file", err.Error())
 	}
-	return nil
 }
@@ -1,12 +1,20 @@
 package cloudamqp
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	frameworkResource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -90,7 +98,7 @@ func TestAccIntegrationMetricPrometheusNewRelicV3_WithoutTags(t *testing.T) {
 					resource.TestCheckResourceAttr(instanceResourceName, "name", params["InstanceName"]),
 					resource.TestCheckResourceAttr(prometheusNewRelicResourceName, "newrelic_v3.#", "1"),
 					resource.TestCheckResourceAttr(prometheusNewRelicResourceName, "newrelic_v3.0.region", params["NewRelicRegion"]),
-					resource.TestCheckResourceAttr(prometheusNewRelicResourceName, "newrelic_v3.0.tags", ""),
+					resource.TestCheckNoResourceAttr(prometheusNewRelicResourceName, "newrelic_v3.0.tags"),
 				),
 			},
 			{
@@ -240,7 +248,7 @@ func TestAccIntegrationMetricPrometheusDatadogV3_WithoutTags(t *testing.T) {
 					resource.TestCheckResourceAttr(instanceResourceName, "name", params["InstanceName"]),
 					resource.TestCheckResourceAttr(prometheusDatadogResourceName, "datadog_v3.#", "1"),
 					resource.TestCheckResourceAttr(prometheusDatadogResourceName, "datadog_v3.0.region", params["DatadogRegion"]),
-					resource.TestCheckResourceAttr(prometheusDatadogResourceName, "datadog_v3.0.tags", ""),
+					resource.TestCheckNoResourceAttr(prometheusDatadogResourceName, "datadog_v3.0.tags"),
 				),
 			},
 			{
@@ -490,7 +498,7 @@ func TestAccIntegrationMetricPrometheusSplunkV2_WithoutTags(t *testing.T) {
 					resource.TestCheckResourceAttr(instanceResourceName, "name", params["InstanceName"]),
 					resource.TestCheckResourceAttr(prometheusSplunkResourceName, "splunk_v2.#", "1"),
 					resource.TestCheckResourceAttr(prometheusSplunkResourceName, "splunk_v2.0.endpoint", params["SplunkEndpoint"]),
-					resource.TestCheckResourceAttr(prometheusSplunkResourceName, "splunk_v2.0.tags", ""),
+					resource.TestCheckNoResourceAttr(prometheusSplunkResourceName, "splunk_v2.0.tags"),
 				),
 			},
 			{
@@ -642,7 +650,7 @@ func TestAccIntegrationMetricPrometheusDynatrace_WithoutTags(t *testing.T) {
 					resource.TestCheckResourceAttr(instanceResourceName, "name", params["InstanceName"]),
 					resource.TestCheckResourceAttr(prometheusDynatraceResourceName, "dynatrace.#", "1"),
 					resource.TestCheckResourceAttr(prometheusDynatraceResourceName, "dynatrace.0.environment_id", params["DynatraceEnvironmentID"]),
-					resource.TestCheckResourceAttr(prometheusDynatraceResourceName, "dynatrace.0.tags", ""),
+					resource.TestCheckNoResourceAttr(prometheusDynatraceResourceName, "dynatrace.0.tags"),
 				),
 			},
 			{
@@ -788,7 +796,7 @@ func TestAccIntegrationMetricPrometheusCloudwatchV3_WithoutTags(t *testing.T) {
 					resource.TestCheckResourceAttr(prometheusCloudwatchResourceName, "cloudwatch_v3.0.iam_role", params["CloudwatchIAMRole"]),
 					resource.TestCheckResourceAttr(prometheusCloudwatchResourceName, "cloudwatch_v3.0.iam_external_id", params["CloudwatchIAMExternalID"]),
 					resource.TestCheckResourceAttr(prometheusCloudwatchResourceName, "cloudwatch_v3.0.region", params["CloudwatchRegion"]),
-					resource.TestCheckResourceAttr(prometheusCloudwatchResourceName, "cloudwatch_v3.0.tags", ""),
+					resource.TestCheckNoResourceAttr(prometheusCloudwatchResourceName, "cloudwatch_v3.0.tags"),
 				),
 			},
 			{
@@ -904,6 +912,8 @@ func TestAccIntegrationMetricPrometheusStackdriverV2_Basic(t *testing.T) {
 				ImportStateIdFunc: testAccImportCombinedStateIdFunc(instanceResourceName, prometheusStackdriverResourceName),
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Credentials file not returned by the API
+				ImportStateVerifyIgnore: []string{"stackdriver_v2.0.credentials_file"},
 			},
 		},
 	})
@@ -1118,3 +1128,263 @@ func TestAccIntegrationMetricPrometheusMetricsFilter_Custom(t *testing.T) {
 		},
 	})
 }
+
+// TestAccIntegrationMetricPrometheus_Validation: Test configuration validation, exactly one integration block and metrics filter.
+func TestAccIntegrationMetricPrometheus_Validation(t *testing.T) {
+	t.Parallel()
+
+	cloudamqpLocalResourceTest(t, http.NotFoundHandler(), resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id = 1234
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of these blocks must be\s+configured`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id = 1234
+						newrelic_v3 {
+							api_key = "key"
+							region  = "us"
+						}
+						splunk_v2 {
+							token    = "token"
+							endpoint = "https://example.com:8088/services/collector"
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`got:\s+\[newrelic_v3,\s+splunk_v2\]`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id    = 1234
+						metrics_filter = ["rabbitmq_connections", "Invalid-Metric"]
+						newrelic_v3 {
+							api_key = "key"
+							region  = "us"
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be a metric\s+name`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id    = 1234
+						metrics_filter = ["rabbitmq_connections", "rabbitmq_connections"]
+						newrelic_v3 {
+							api_key = "key"
+							region  = "us"
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Duplicate List Value`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id = 1234
+						stackdriver_v2 {
+							credentials_file = "bm90LWpzb24="
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`failed to parse stackdriver\s+credentials\s+JSON`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id = 1234
+						datadog_v3 {
+							api_key = "key"
+							region  = "us2"
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Region "us2" is not a Datadog site`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id = 1234
+						datadog_v3 {
+							api_key = "key"
+							region  = "us1"
+							tags    = "env:prod,service"
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`tag "service" must be in key:value format`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric_prometheus" "prometheus" {
+						instance_id = 1234
+						opentelemetry {
+							endpoint = "otlp.example.com:4317"
+							protocol = "udp"
+						}
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)must be an http or https URL.*value must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccIntegrationMetricPrometheusOpenTelemetry_Basic: Create, update and import OpenTelemetry
+// prometheus metric integration against the fake API.
+func TestAccIntegrationMetricPrometheusOpenTelemetry_Basic(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	resourceName := "cloudamqp_integration_metric_prometheus.opentelemetry"
+
+	config := func(block string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_integration_metric_prometheus" "opentelemetry" {
+				instance_id    = %d
+				metrics_filter = ["rabbitmq_queue_messages", "rabbitmq_connections"]
+				opentelemetry {
+					endpoint = "https://otlp.example.com:4317"
+					%s
+				}
+			}`, instanceID, block)
+	}
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config(`headers = { authorization = "Bearer token" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "opentelemetry.0.endpoint", "https://otlp.example.com:4317"),
+					resource.TestCheckResourceAttr(resourceName, "opentelemetry.0.headers.authorization", "Bearer token"),
+					resource.TestCheckResourceAttr(resourceName, "opentelemetry.0.protocol", "grpc"),
+					resource.TestCheckNoResourceAttr(resourceName, "opentelemetry.0.resource_attributes.%"),
+					resource.TestCheckResourceAttr(resourceName, "metrics_filter.#", "2"),
+				),
+			},
+			{
+				Config: config(`
+					headers             = { authorization = "Bearer token2" }
+					protocol            = "http"
+					resource_attributes = { "service.name" = "rabbitmq" }
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "opentelemetry.0.headers.authorization", "Bearer token2"),
+					resource.TestCheckResourceAttr(resourceName, "opentelemetry.0.protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "opentelemetry.0.resource_attributes.service.name", "rabbitmq"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccImportCombinedIdFunc(fmt.Sprint(instanceID), resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestIntegrationMetricPrometheusUpgradeStateV0: Upgrade state stored by the SDKv2 resource.
+func TestIntegrationMetricPrometheusUpgradeStateV0(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		r         = NewIntegrationMetricPrometheusResource()
+		schemaRes frameworkResource.SchemaResponse
+	)
+	r.Schema(ctx, frameworkResource.SchemaRequest{}, &schemaRes)
+
+	server, err := providerserver.NewProtocol5WithError(New("1.0", http.DefaultClient))()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	upgrade := func(rawState string) integrationMetricPrometheusResourceModel {
+		t.Helper()
+
+		resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+			TypeName: "cloudamqp_integration_metric_prometheus",
+			Version:  0,
+			RawState: &tfprotov5.RawState{JSON: []byte(rawState)},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("%s: %s", d.Summary, d.Detail)
+		}
+
+		value, err := resp.UpgradedState.Unmarshal(schemaRes.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var state integrationMetricPrometheusResourceModel
+		diags := tfsdk.State{Schema: schemaRes.Schema, Raw: value}.Get(ctx, &state)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return state
+	}
+
+	state := upgrade(`{
+		"id": "318",
+		"instance_id": 574,
+		"metrics_filter": ["rabbitmq_connections"],
+		"newrelic_v3": [],
+		"datadog_v3": [{"api_key": "key", "region": "us1", "tags": "", "rabbitmq_dashboard_metrics_format": true}],
+		"azure_monitor": [],
+		"splunk_v2": [],
+		"dynatrace": [],
+		"cloudwatch_v3": [],
+		"stackdriver_v2": []
+	}`)
+	if state.ID.ValueString() != "318" || state.InstanceID.ValueInt64() != 574 || len(state.MetricsFilter.Elements()) != 1 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+	if len(state.DatadogV3) != 1 || state.DatadogV3[0].Region.ValueString() != "us1" ||
+		!state.DatadogV3[0].Tags.IsNull() || !state.DatadogV3[0].RabbitMQDashboardMetricsFormat.ValueBool() {
+		t.Fatalf("unexpected datadog_v3 block: %+v", state.DatadogV3)
+	}
+
+	state = upgrade(`{
+		"id": "319",
+		"instance_id": 574,
+		"newrelic_v3": [],
+		"datadog_v3": [],
+		"azure_monitor": [],
+		"splunk_v2": [],
+		"dynatrace": [],
+		"cloudwatch_v3": [],
+		"stackdriver_v2": [{"credentials_file": "", "project_id": "test-project", "client_email": "test@serviceaccount.com",
+			"private_key": "key", "private_key_id": "test-key-id", "tags": "env=test"}]
+	}`)
+	if len(state.StackdriverV2) != 1 {
+		t.Fatalf("unexpected stackdriver_v2 block: %+v", state.StackdriverV2)
+	}
+	stackdriver := state.StackdriverV2[0]
+	if !stackdriver.CredentialsFile.IsNull() || stackdriver.ProjectID.ValueString() != "test-project" ||
+		stackdriver.Tags.ValueString() != "env=test" {
+		t.Fatalf("unexpected stackdriver_v2 block: %+v", stackdriver)
+	}
+	if !state.MetricsFilter.IsNull() {
+		t.Fatalf("expected metrics filter to be null, got: %s", state.MetricsFilter)
+	}
+}
@@ -2,9 +2,12 @@ package cloudamqp
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -199,3 +202,134 @@ func TestAccIntegrationMetric_NewRelic(t *testing.T) {
 		},
 	})
 }
+
+// TestAccIntegrationMetric_Validation: Datadog site and tags validated during plan.
+func TestAccIntegrationMetric_Validation(t *testing.T) {
+	t.Parallel()
+
+	cloudamqpLocalResourceTest(t, http.NotFoundHandler(), resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "cloudamqp_integration_metric" "datadog" {
+						instance_id = 1234
+						name        = "datadog_v2"
+						api_key     = "key"
+						region      = "ap3"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Region "ap3" is not a Datadog site`),
+			},
+			{
+				Config: `
+					resource "cloudamqp_integration_metric" "datadog" {
+						instance_id = 1234
+						name        = "datadog_v2"
+						api_key     = "key"
+						region      = "us1"
+						tags        = "env:prod,service:"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`tag "service:" is missing a value`),
+			},
+		},
+	})
+}
+
+// TestAccIntegrationMetric_OpenTelemetry: Create, update and import OpenTelemetry metric
+// integration against the fake API.
+func TestAccIntegrationMetric_OpenTelemetry(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	resourceName := "cloudamqp_integration_metric.opentelemetry"
+
+	config := func(endpoint string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_integration_metric" "opentelemetry" {
+				instance_id         = %d
+				name                = "opentelemetry"
+				endpoint            = "%s"
+				headers             = { "x-api-key" = "secret" }
+				protocol            = "http"
+				resource_attributes = { "service.name" = "rabbitmq" }
+			}`, instanceID, endpoint)
+	}
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "cloudamqp_integration_metric" "opentelemetry" {
+					instance_id = %d
+					name        = "opentelemetry"
+				}`, instanceID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`endpoint is required for the opentelemetry\s+integration`),
+			},
+			{
+				Config: config("https://otlp.example.com:4318"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "opentelemetry"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://otlp.example.com:4318"),
+					resource.TestCheckResourceAttr(resourceName, "headers.x-api-key", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "resource_attributes.service.name", "rabbitmq"),
+				),
+			},
+			{
+				Config: config("https://otlp.eu.example.com:4318"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "endpoint", "https://otlp.eu.example.com:4318"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccImportCombinedIdFunc(fmt.Sprint(instanceID), resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccIntegrationMetric_ImportByName: Import metric integration with {instance_id},{integration_name}.
+func TestAccIntegrationMetric_ImportByName(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	resourceName := "cloudamqp_integration_metric.opentelemetry"
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_integration_metric" "opentelemetry" {
+						instance_id = %d
+						name        = "opentelemetry"
+						endpoint    = "https://otlp.example.com:4318"
+					}`, instanceID),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%d,opentelemetry", instanceID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportStateId: fmt.Sprintf("%d,datadog_v2", instanceID),
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`no datadog_v2 integration found on the instance`),
+			},
+		},
+	})
+}
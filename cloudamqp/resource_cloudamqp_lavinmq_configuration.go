@@ -0,0 +1,423 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance/configuration"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &lavinMqConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &lavinMqConfigurationResource{}
+	_ resource.ResourceWithImportState = &lavinMqConfigurationResource{}
+	_ resource.ResourceWithModifyPlan  = &lavinMqConfigurationResource{}
+)
+
+type lavinMqConfigurationResource struct {
+	client *api.API
+}
+
+func NewLavinMqConfigurationResource() resource.Resource {
+	return &lavinMqConfigurationResource{}
+}
+
+type lavinMqConfigurationResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	InstanceID              types.Int64  `tfsdk:"instance_id"`
+	SegmentSize             types.Int64  `tfsdk:"segment_size"`
+	MaxMessageSize          types.Int64  `tfsdk:"max_message_size"`
+	DefaultConsumerPrefetch types.Int64  `tfsdk:"default_consumer_prefetch"`
+	Heartbeat               types.Int64  `tfsdk:"heartbeat"`
+	ChannelMax              types.Int64  `tfsdk:"channel_max"`
+	ConnectionMax           types.Int64  `tfsdk:"connection_max"`
+	// Sleep/timeout for retries
+	Sleep   types.Int64 `tfsdk:"sleep"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func (r *lavinMqConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_lavinmq_configuration"
+}
+
+func (r *lavinMqConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Resource ID (instance_id as string)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Instance identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"segment_size": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Size in bytes of the segment files messages are stored in.",
+				Validators: []validator.Int64{
+					int64validator.Between(1048576, 1073741824),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_message_size": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The largest allowed message payload size in bytes.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 536870912),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_consumer_prefetch": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Description: "Prefetch limit of consumers on channels without a prefetch set. 0 means " +
+					"no limit.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"heartbeat": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Set the server AMQP heartbeat timeout in seconds.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_max": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Set the maximum permissible number of channels per connection. 0 means no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_max": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Set the maximum permissible number of connections. 0 means no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"sleep": schema.Int64Attribute{
+				Optional:    true,
+				Default:     int64default.StaticInt64(60),
+				Computed:    true,
+				Description: "Configurable sleep time in seconds between retries for LavinMQ configuration",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Default:     int64default.StaticInt64(3600),
+				Computed:    true,
+				Description: "Configurable timeout time in seconds for LavinMQ configuration",
+			},
+		},
+	}
+}
+
+func (r *lavinMqConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *lavinMqConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan lavinMqConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The backend of an instance doesn't change, only check when attaching to an instance.
+	var stateInstanceID types.Int64
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("instance_id"), &stateInstanceID)...)
+	}
+	if !plan.InstanceID.IsUnknown() && !plan.InstanceID.Equal(stateInstanceID) && r.client != nil {
+		resp.Diagnostics.Append(r.validateBackend(ctx, plan.InstanceID.ValueInt64())...)
+	}
+}
+
+// validateBackend: the configuration can only be attached to LavinMQ instances.
+func (r *lavinMqConfigurationResource) validateBackend(ctx context.Context, instanceID int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data, err := r.client.ReadInstance(ctx, strconv.FormatInt(instanceID, 10))
+	if err != nil {
+		diags.AddError("Failed to read instance", fmt.Sprintf("Could not read instance %d: %s", instanceID, err))
+		return diags
+	}
+	backend, _ := data["backend"].(string)
+	if backend != "" && backend != "lavinmq" {
+		diags.AddAttributeError(path.Root("instance_id"), "Unsupported Instance Backend",
+			fmt.Sprintf("LavinMQ configuration requires a LavinMQ instance, instance %d runs %s. Use "+
+				"cloudamqp_rabbitmq_configuration for RabbitMQ instances.", instanceID, backend))
+	}
+	return diags
+}
+
+func (r *lavinMqConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lavinMqConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sleep := plan.Sleep.ValueInt64()
+	timeout := plan.Timeout.ValueInt64()
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	instanceID := plan.InstanceID.ValueInt64()
+	request := r.populateCreateRequest(plan)
+
+	err := r.client.UpdateLavinMqConfiguration(timeoutCtx, instanceID, request, sleep)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create LavinMQ configuration: %s", err.Error()))
+		return
+	}
+
+	dataResp, err := r.client.ReadLavinMqConfiguration(ctx, instanceID, sleep)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read LavinMQ configuration: %s", err.Error()))
+		return
+	}
+
+	if dataResp == nil {
+		resp.Diagnostics.AddError("API Error", "Failed to read LavinMQ configuration: received nil response")
+		return
+	}
+
+	r.populateResourceModel(&plan, dataResp, instanceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *lavinMqConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lavinMqConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sleep := state.Sleep.ValueInt64()
+	timeout := state.Timeout.ValueInt64()
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	instanceID := state.InstanceID.ValueInt64()
+
+	data, err := r.client.ReadLavinMqConfiguration(timeoutCtx, instanceID, sleep)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+	if data == nil {
+		tflog.Warn(ctx, fmt.Sprintf("LavinMQ configuration resource drift for instance ID %d, trigger re-create", instanceID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.populateResourceModel(&state, data, instanceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *lavinMqConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state lavinMqConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sleep := plan.Sleep.ValueInt64()
+	timeout := plan.Timeout.ValueInt64()
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	instanceID := plan.InstanceID.ValueInt64()
+	request, changed := r.populateUpdateRequest(plan, state)
+
+	if !changed {
+		// No LavinMQ configuration changes detected, only save the state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	err := r.client.UpdateLavinMqConfiguration(timeoutCtx, instanceID, request, sleep)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update LavinMQ configuration: %s", err.Error()))
+		return
+	}
+
+	dataResp, err := r.client.ReadLavinMqConfiguration(ctx, instanceID, sleep)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read LavinMQ configuration: %s", err.Error()))
+		return
+	}
+
+	if dataResp == nil {
+		resp.Diagnostics.AddError("API Error", "Failed to read LavinMQ configuration: received nil response")
+		return
+	}
+
+	r.populateResourceModel(&plan, dataResp, instanceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *lavinMqConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: configuration is not deleted from the server only removed from the state.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *lavinMqConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+	instanceID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected numeric instance_id, got: %q", id))
+		return
+	}
+	resp.Diagnostics.Append(r.validateBackend(ctx, instanceID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.SetAttribute(ctx, path.Root("id"), id)
+	resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)
+	resp.State.SetAttribute(ctx, path.Root("sleep"), 60)     // default value
+	resp.State.SetAttribute(ctx, path.Root("timeout"), 3600) // default value
+}
+
+// Convert API response to resource model
+func (r *lavinMqConfigurationResource) populateResourceModel(resourceModel *lavinMqConfigurationResourceModel, data *model.LavinMqConfigResponse, instanceID int64) {
+	resourceModel.ID = types.StringValue(strconv.Itoa(int(instanceID)))
+	resourceModel.InstanceID = types.Int64Value(instanceID)
+	resourceModel.SegmentSize = types.Int64Value(data.SegmentSize)
+	resourceModel.MaxMessageSize = types.Int64Value(data.MaxMessageSize)
+	resourceModel.DefaultConsumerPrefetch = types.Int64Value(data.DefaultConsumerPrefetch)
+	resourceModel.Heartbeat = types.Int64Value(data.Heartbeat)
+	resourceModel.ChannelMax = types.Int64Value(data.ChannelMax)
+	resourceModel.ConnectionMax = types.Int64Value(data.ConnectionMax)
+}
+
+// Populate API create request from resource model
+func (r *lavinMqConfigurationResource) populateCreateRequest(plan lavinMqConfigurationResourceModel) model.LavinMqConfigRequest {
+	request := model.LavinMqConfigRequest{}
+
+	if !plan.SegmentSize.IsUnknown() {
+		request.SegmentSize = plan.SegmentSize.ValueInt64Pointer()
+	}
+
+	if !plan.MaxMessageSize.IsUnknown() {
+		request.MaxMessageSize = plan.MaxMessageSize.ValueInt64Pointer()
+	}
+
+	if !plan.DefaultConsumerPrefetch.IsUnknown() {
+		request.DefaultConsumerPrefetch = plan.DefaultConsumerPrefetch.ValueInt64Pointer()
+	}
+
+	if !plan.Heartbeat.IsUnknown() {
+		request.Heartbeat = plan.Heartbeat.ValueInt64Pointer()
+	}
+
+	if !plan.ChannelMax.IsUnknown() {
+		request.ChannelMax = plan.ChannelMax.ValueInt64Pointer()
+	}
+
+	if !plan.ConnectionMax.IsUnknown() {
+		request.ConnectionMax = plan.ConnectionMax.ValueInt64Pointer()
+	}
+
+	return request
+}
+
+// Populate API update request from resource model
+func (r *lavinMqConfigurationResource) populateUpdateRequest(plan, state lavinMqConfigurationResourceModel) (model.LavinMqConfigRequest, bool) {
+	request := model.LavinMqConfigRequest{}
+	changed := false
+
+	if !plan.SegmentSize.IsNull() && !plan.SegmentSize.Equal(state.SegmentSize) {
+		request.SegmentSize = plan.SegmentSize.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.MaxMessageSize.IsNull() && !plan.MaxMessageSize.Equal(state.MaxMessageSize) {
+		request.MaxMessageSize = plan.MaxMessageSize.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.DefaultConsumerPrefetch.IsNull() && !plan.DefaultConsumerPrefetch.Equal(state.DefaultConsumerPrefetch) {
+		request.DefaultConsumerPrefetch = plan.DefaultConsumerPrefetch.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.Heartbeat.IsNull() && !plan.Heartbeat.Equal(state.Heartbeat) {
+		request.Heartbeat = plan.Heartbeat.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.ChannelMax.IsNull() && !plan.ChannelMax.Equal(state.ChannelMax) {
+		request.ChannelMax = plan.ChannelMax.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.ConnectionMax.IsNull() && !plan.ConnectionMax.Equal(state.ConnectionMax) {
+		request.ConnectionMax = plan.ConnectionMax.ValueInt64Pointer()
+		changed = true
+	}
+
+	return request, changed
+}
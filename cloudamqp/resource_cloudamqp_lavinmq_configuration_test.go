@@ -0,0 +1,107 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccLavinMqConfiguration_Basic: Update LavinMQ configuration and import.
+func TestAccLavinMqConfiguration_Basic(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	data, err := server.API().CreateInstance(context.Background(), map[string]any{
+		"name":   t.Name(),
+		"plan":   "penguin-1",
+		"region": "amazon-web-services::us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lavinMqConfigResourceName := "cloudamqp_lavinmq_configuration.lavinmq_config"
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_lavinmq_configuration" "lavinmq_config" {
+						instance_id               = %v
+						heartbeat                 = 60
+						default_consumer_prefetch = 1000
+					}`, data["id"]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "heartbeat", "60"),
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "default_consumer_prefetch", "1000"),
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "segment_size", "8388608"),
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "max_message_size", "134217728"),
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "channel_max", "2048"),
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "connection_max", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_lavinmq_configuration" "lavinmq_config" {
+						instance_id               = %v
+						heartbeat                 = 60
+						default_consumer_prefetch = 1000
+						segment_size              = 33554432
+						connection_max            = 5000
+					}`, data["id"]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "segment_size", "33554432"),
+					resource.TestCheckResourceAttr(lavinMqConfigResourceName, "connection_max", "5000"),
+				),
+			},
+			{
+				ResourceName:            lavinMqConfigResourceName,
+				ImportStateId:           fmt.Sprint(data["id"]),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sleep", "timeout"},
+			},
+		},
+	})
+}
+
+// TestAccLavinMqConfiguration_RabbitMqInstance: Refuse to attach to a RabbitMQ instance.
+func TestAccLavinMqConfiguration_RabbitMqInstance(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	instanceID := fakeAPIInstance(t, server)
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_lavinmq_configuration" "lavinmq_config" {
+						instance_id = %d
+						heartbeat   = 60
+					}`, instanceID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`LavinMQ configuration requires a LavinMQ instance, instance\s+\d+\s+runs\s+rabbitmq`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_lavinmq_configuration" "lavinmq_config" {
+						instance_id = %d
+					}`, instanceID),
+				ResourceName:  "cloudamqp_lavinmq_configuration.lavinmq_config",
+				ImportStateId: fmt.Sprint(instanceID),
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unsupported Instance Backend`),
+			},
+		},
+	})
+}
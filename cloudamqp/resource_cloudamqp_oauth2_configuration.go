@@ -3,6 +3,7 @@ package cloudamqp
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -25,11 +26,17 @@ import (
 )
 
 var (
-	_ resource.Resource                = &oauth2ConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &oauth2ConfigurationResource{}
-	_ resource.ResourceWithImportState = &oauth2ConfigurationResource{}
+	_ resource.Resource                   = &oauth2ConfigurationResource{}
+	_ resource.ResourceWithConfigure      = &oauth2ConfigurationResource{}
+	_ resource.ResourceWithImportState    = &oauth2ConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &oauth2ConfigurationResource{}
+	_ resource.ResourceWithModifyPlan     = &oauth2ConfigurationResource{}
 )
 
+// oauth2IssuerClient: fetches the discovery document and key set of the issuer, not the CloudAMQP
+// API client.
+var oauth2IssuerClient = &http.Client{Timeout: 30 * time.Second}
+
 type oauth2ConfigurationResource struct {
 	client *api.API
 }
@@ -52,6 +59,7 @@ type oauth2ConfigurationResourceModel struct {
 	OauthScopes             types.List   `tfsdk:"oauth_scopes"`
 	Audience                types.String `tfsdk:"audience"`
 	DisableBasicAuth        types.Bool   `tfsdk:"disable_basic_auth"`
+	ValidateIssuer          types.Bool   `tfsdk:"validate_issuer"`
 	Sleep                   types.Int64  `tfsdk:"sleep"`
 	Timeout                 types.Int64  `tfsdk:"timeout"`
 }
@@ -161,6 +169,10 @@ func (r *oauth2ConfigurationResource) Schema(ctx context.Context, req resource.S
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"validate_issuer": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate the issuer against its OpenID Connect discovery document and key set when planning",
+			},
 			"sleep": schema.Int64Attribute{
 				Optional:    true,
 				Default:     int64default.StaticInt64(60),
@@ -209,6 +221,46 @@ func (r *oauth2ConfigurationResource) ImportState(ctx context.Context, req resou
 	resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)
 }
 
+func (r *oauth2ConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config oauth2ConfigurationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without an OAuth client there is no way to sign in to the management interface
+	if config.DisableBasicAuth.ValueBool() && !config.OauthClientId.IsUnknown() &&
+		config.OauthClientId.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("disable_basic_auth"), "Missing OAuth Client",
+			"disable_basic_auth can only be enabled together with oauth_client_id, otherwise no one "+
+				"can sign in to the management interface")
+	}
+}
+
+// ModifyPlan: validates the issuer when known, also when planning during apply for issuers unknown
+// at plan time.
+func (r *oauth2ConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan oauth2ConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ValidateIssuer.ValueBool() || plan.Issuer.IsUnknown() {
+		return
+	}
+
+	issuer := plan.Issuer.ValueString()
+	if err := utils.ValidateOIDCIssuer(ctx, oauth2IssuerClient, issuer); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("issuer"), "Invalid OAuth2 Issuer",
+			fmt.Sprintf("Failed to validate issuer %q: %s", issuer, err))
+	}
+}
+
 func (r *oauth2ConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan oauth2ConfigurationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -2,8 +2,12 @@ package cloudamqp
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -221,3 +225,87 @@ func TestAccOAuth2Configuration_MinimalConfig(t *testing.T) {
 		},
 	})
 }
+
+// testOIDCServer: OpenID Connect provider with realms "test", with a signing key, and "empty",
+// without keys. Other realms are not found.
+func testOIDCServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	keys := map[string]string{
+		"test":  `{"keys": [{"kty": "RSA", "kid": "test", "use": "sig", "n": "AQAB", "e": "AQAB"}]}`,
+		"empty": `{"keys": []}`,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /realms/{realm}/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := keys[r.PathValue("realm")]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		issuer := fmt.Sprintf("http://%s/realms/%s", r.Host, r.PathValue("realm"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"issuer": %q, "jwks_uri": %q}`, issuer, issuer+"/protocol/openid-connect/certs")
+	})
+	mux.HandleFunc("GET /realms/{realm}/protocol/openid-connect/certs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, keys[r.PathValue("realm")])
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestAccOAuth2Configuration_ValidateIssuer: Validate the issuer against its discovery document
+// and key set, and refuse disabling basic auth without an OAuth client.
+func TestAccOAuth2Configuration_ValidateIssuer(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	oidcServer := testOIDCServer(t)
+
+	oauth2ConfigResourceName := "cloudamqp_oauth2_configuration.oauth2_config"
+
+	config := func(realm, extra string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_oauth2_configuration" "oauth2_config" {
+				instance_id        = %d
+				resource_server_id = "test-resource-server"
+				issuer             = "%s/realms/%s"
+				validate_issuer    = true
+				%s
+			}`, instanceID, oidcServer.URL, realm, extra)
+	}
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      config("test", `disable_basic_auth = true`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`disable_basic_auth can only be enabled together with oauth_client_id`),
+			},
+			{
+				Config:      config("missing", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Failed to validate issuer.*failed to\s+fetch discovery document`),
+			},
+			{
+				Config:      config("empty", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Failed to validate issuer.*has no\s+signing keys`),
+			},
+			{
+				Config: config("test", `
+					oauth_client_id    = "test-client-id"
+					disable_basic_auth = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(oauth2ConfigResourceName, "issuer", oidcServer.URL+"/realms/test"),
+					resource.TestCheckResourceAttr(oauth2ConfigResourceName, "validate_issuer", "true"),
+					resource.TestCheckResourceAttr(oauth2ConfigResourceName, "oauth_client_id", "test-client-id"),
+					resource.TestCheckResourceAttr(oauth2ConfigResourceName, "disable_basic_auth", "true"),
+				),
+			},
+		},
+	})
+}
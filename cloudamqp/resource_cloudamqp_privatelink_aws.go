@@ -15,10 +15,11 @@ import (
 
 func resourcePrivateLinkAws() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourcePrivateLinkAwsCreate,
-		ReadContext:   resourcePrivateLinkAwsRead,
-		UpdateContext: resourcePrivateLinkAwsUpdate,
-		DeleteContext: resourcePrivateLinkAwsDelete,
+		DeprecationMessage: "Use cloudamqp_vpc_connect instead, existing resources can be migrated with a moved block.",
+		CreateContext:      resourcePrivateLinkAwsCreate,
+		ReadContext:        resourcePrivateLinkAwsRead,
+		UpdateContext:      resourcePrivateLinkAwsUpdate,
+		DeleteContext:      resourcePrivateLinkAwsDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -15,10 +15,11 @@ import (
 
 func resourcePrivateLinkAzure() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourcePrivateLinkAzureCreate,
-		ReadContext:   resourcePrivateLinkAzureRead,
-		UpdateContext: resourcePrivateLinkAzureUpdate,
-		DeleteContext: resourcePrivateLinkAzureDelete,
+		DeprecationMessage: "Use cloudamqp_vpc_connect instead, existing resources can be migrated with a moved block.",
+		CreateContext:      resourcePrivateLinkAzureCreate,
+		ReadContext:        resourcePrivateLinkAzureRead,
+		UpdateContext:      resourcePrivateLinkAzureUpdate,
+		DeleteContext:      resourcePrivateLinkAzureDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
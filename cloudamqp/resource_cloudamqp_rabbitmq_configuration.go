@@ -3,15 +3,20 @@ package cloudamqp
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
 	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance/configuration"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	goversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,9 +32,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &rabbitMqConfigurationResource{}
-	_ resource.ResourceWithConfigure   = &rabbitMqConfigurationResource{}
-	_ resource.ResourceWithImportState = &rabbitMqConfigurationResource{}
+	_ resource.Resource                   = &rabbitMqConfigurationResource{}
+	_ resource.ResourceWithConfigure      = &rabbitMqConfigurationResource{}
+	_ resource.ResourceWithImportState    = &rabbitMqConfigurationResource{}
+	_ resource.ResourceWithModifyPlan     = &rabbitMqConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &rabbitMqConfigurationResource{}
 )
 
 type rabbitMqConfigurationResource struct {
@@ -62,6 +69,22 @@ type rabbitMqConfigurationResourceModel struct {
 	SSLCertLoginFrom           types.String `tfsdk:"ssl_cert_login_from"`
 	SSLOptionsFailIfNoPeerCert types.Bool   `tfsdk:"ssl_options_fail_if_no_peer_cert"`
 	SSLOptionsVerify           types.String `tfsdk:"ssl_options_verify"`
+	// Queue defaults
+	DefaultQueueType                         types.String `tfsdk:"default_queue_type"`
+	QuorumClusterSize                        types.Int64  `tfsdk:"quorum_cluster_size"`
+	QuorumCommandsSoftLimit                  types.Int64  `tfsdk:"quorum_commands_soft_limit"`
+	QuorumContinuousMembershipReconciliation types.Bool   `tfsdk:"quorum_continuous_membership_reconciliation"`
+	// Stream settings
+	StreamFrameMax  types.Int64 `tfsdk:"stream_frame_max"`
+	StreamHeartbeat types.Int64 `tfsdk:"stream_heartbeat"`
+	// Resource limits
+	VmMemoryHighWatermarkPagingRatio types.Float64 `tfsdk:"vm_memory_high_watermark_paging_ratio"`
+	DiskFreeLimit                    types.Int64   `tfsdk:"disk_free_limit"`
+	// Log levels
+	LogDefaultLevel    types.String `tfsdk:"log_default_level"`
+	LogConnectionLevel types.String `tfsdk:"log_connection_level"`
+	// Manage unset attributes with default values
+	ManageAll types.Bool `tfsdk:"manage_all"`
 	// Sleep/timeout for retries
 	Sleep   types.Int64 `tfsdk:"sleep"`
 	Timeout types.Int64 `tfsdk:"timeout"`
@@ -277,6 +300,123 @@ func (r *rabbitMqConfigurationResource) Schema(ctx context.Context, req resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"default_queue_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Queue type used when a client declares a queue without the x-queue-type " +
+					"argument. Requires RabbitMQ 3.13 or later.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("classic", "quorum"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quorum_cluster_size": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Default number of replicas of a quorum queue.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"quorum_commands_soft_limit": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Description: "Maximum number of unconfirmed messages a channel accepts before entering " +
+					"flow control for quorum queues.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"quorum_continuous_membership_reconciliation": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Continuously grow quorum queues back to their target replica count. " +
+					"Requires RabbitMQ 3.13 or later.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stream_frame_max": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum frame size in bytes for stream protocol connections.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(8192),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"stream_heartbeat": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Set the heartbeat timeout in seconds for stream protocol connections.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"vm_memory_high_watermark_paging_ratio": schema.Float64Attribute{
+				Optional: true,
+				Computed: true,
+				Description: "When classic queues start paging messages to disk, as relative to the " +
+					"memory high watermark. Not supported from RabbitMQ 4.0.",
+				Validators: []validator.Float64{
+					float64validator.Between(0.0, 1.0),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"disk_free_limit": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Free disk space limit in bytes, publishers are blocked below the limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_default_level": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Default log level for categories without their own level.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("debug", "info", "warning", "error", "critical", "none"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_connection_level": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Log level for connection lifecycle events.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("debug", "info", "warning", "error", "critical", "none"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"manage_all": schema.BoolAttribute{
+				Optional: true,
+				Description: "Reset configuration attributes not set to their documented default values and " +
+					"require attributes without a documented default to be set, changes made outside of " +
+					"Terraform are shown as drift.",
+			},
 			"sleep": schema.Int64Attribute{
 				Optional:    true,
 				Default:     int64default.StaticInt64(60),
@@ -308,6 +448,219 @@ func (r *rabbitMqConfigurationResource) Configure(ctx context.Context, req resou
 	r.client = client
 }
 
+// rabbitMqConfigurationVersion: RabbitMQ versions supporting a configuration attribute, from
+// MinVersion and before MaxVersion, when set.
+type rabbitMqConfigurationVersion struct {
+	MinVersion string
+	MaxVersion string
+}
+
+var rabbitMqConfigurationVersions = map[string]rabbitMqConfigurationVersion{
+	"mqtt_max_session_expiry_interval_seconds":    {MinVersion: "3.13"},
+	"default_queue_type":                          {MinVersion: "3.13"},
+	"quorum_continuous_membership_reconciliation": {MinVersion: "3.13"},
+	"stream_frame_max":                            {MinVersion: "3.9"},
+	"stream_heartbeat":                            {MinVersion: "3.9"},
+	"vm_memory_high_watermark_paging_ratio":       {MaxVersion: "4.0"},
+}
+
+// rabbitMqConfigurationDefaults: documented default values, applied to attributes not set in the
+// configuration when manage_all is enabled. mqtt_vhost defaults to the vhost of the instance.
+// Attributes without a documented default must be set when manage_all is enabled.
+var rabbitMqConfigurationDefaults = map[string]attr.Value{
+	"heartbeat":                                types.Int64Value(120),
+	"connection_max":                           types.Int64Value(-1),
+	"channel_max":                              types.Int64Value(128),
+	"consumer_timeout":                         types.Int64Value(7200000),
+	"vm_memory_high_watermark":                 types.Float64Value(0.81),
+	"queue_index_embed_msgs_below":             types.Int64Value(4096),
+	"max_message_size":                         types.Int64Value(134217728),
+	"log_exchange_level":                       types.StringValue("error"),
+	"mqtt_max_session_expiry_interval_seconds": types.Int64Value(1800),
+}
+
+// supportedRabbitMqConfiguration: whether the RabbitMQ version supports the attribute, unparsable
+// versions are assumed to support it.
+func supportedRabbitMqConfiguration(name, rmqVersion string) bool {
+	supported, ok := rabbitMqConfigurationVersions[name]
+	if !ok {
+		return true
+	}
+	current, err := goversion.NewVersion(rmqVersion)
+	if err != nil {
+		return true
+	}
+	if supported.MinVersion != "" && current.LessThan(goversion.Must(goversion.NewVersion(supported.MinVersion))) {
+		return false
+	}
+	if supported.MaxVersion != "" && !current.LessThan(goversion.Must(goversion.NewVersion(supported.MaxVersion))) {
+		return false
+	}
+	return true
+}
+
+// ValidateConfig: with manage_all enabled, attributes without a documented default must be set so
+// every value is managed and changes made outside of Terraform are shown as drift. Attributes
+// depending on the RabbitMQ version are checked when planning.
+func (r *rabbitMqConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config rabbitMqConfigurationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !config.ManageAll.ValueBool() {
+		return
+	}
+
+	for _, name := range config.unsetWithoutDefault(false) {
+		addMissingManagedAttributeError(&resp.Diagnostics, name)
+	}
+}
+
+func (r *rabbitMqConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config rabbitMqConfigurationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only check attributes set in the configuration, state may hold values from before an upgrade.
+	// mqtt_max_session_expiry_interval_seconds is still validated by the API when set.
+	var names []string
+	for name, value := range config.configurationValues() {
+		if _, ok := rabbitMqConfigurationVersions[name]; ok && !value.IsNull() &&
+			name != "mqtt_max_session_expiry_interval_seconds" {
+			names = append(names, name)
+		}
+	}
+	manageAll := config.ManageAll.ValueBool()
+	if (len(names) == 0 && !manageAll) || config.InstanceID.IsUnknown() || r.client == nil {
+		return
+	}
+
+	instanceID := config.InstanceID.ValueInt64()
+	data, err := r.client.ReadInstance(ctx, strconv.FormatInt(instanceID, 10))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read instance", fmt.Sprintf("Could not read instance %d: %s", instanceID, err))
+		return
+	}
+	rmqVersion, _ := data["rmq_version"].(string)
+
+	sort.Strings(names)
+	for _, name := range names {
+		if rmqVersion == "" || supportedRabbitMqConfiguration(name, rmqVersion) {
+			continue
+		}
+		supported := rabbitMqConfigurationVersions[name]
+		if supported.MinVersion != "" {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Unsupported RabbitMQ Version",
+				fmt.Sprintf("%s requires RabbitMQ %s or later, instance %d runs %s",
+					name, supported.MinVersion, instanceID, rmqVersion))
+		} else {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Unsupported RabbitMQ Version",
+				fmt.Sprintf("%s is not supported from RabbitMQ %s, instance %d runs %s",
+					name, supported.MaxVersion, instanceID, rmqVersion))
+		}
+	}
+	if manageAll {
+		for _, name := range config.unsetWithoutDefault(true) {
+			if rmqVersion == "" || supportedRabbitMqConfiguration(name, rmqVersion) {
+				addMissingManagedAttributeError(&resp.Diagnostics, name)
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() || !manageAll {
+		return
+	}
+
+	// Plan defaults for attributes not set, the refreshed state differing from them shows as drift.
+	defaults := maps.Clone(rabbitMqConfigurationDefaults)
+	if urlStr, _ := data["url"].(string); urlStr != "" {
+		if vhost, ok := r.client.UrlInformation(urlStr)["vhost"].(string); ok {
+			defaults["mqtt_vhost"] = types.StringValue(vhost)
+		}
+	}
+	configured := config.configurationValues()
+	for name, value := range defaults {
+		if !configured[name].IsNull() {
+			continue
+		}
+		if rmqVersion != "" && !supportedRabbitMqConfiguration(name, rmqVersion) {
+			value = nullValue(value)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// configurationValues: the configuration attributes by name.
+func (m rabbitMqConfigurationResourceModel) configurationValues() map[string]attr.Value {
+	return map[string]attr.Value{
+		"heartbeat":                                   m.Heartbeat,
+		"connection_max":                              m.ConnectionMax,
+		"channel_max":                                 m.ChannelMax,
+		"consumer_timeout":                            m.ConsumerTimeout,
+		"vm_memory_high_watermark":                    m.VmMemoryHighWatermark,
+		"queue_index_embed_msgs_below":                m.QueueIndexEmbedMsgsBelow,
+		"max_message_size":                            m.MaxMessageSize,
+		"log_exchange_level":                          m.LogExchangeLevel,
+		"cluster_partition_handling":                  m.ClusterPartitionHandling,
+		"message_interceptors_timestamp_overwrite":    m.MessageInterceptorsTimestampOverwrite,
+		"mqtt_vhost":                                  m.MQTTVhost,
+		"mqtt_exchange":                               m.MQTTExchange,
+		"mqtt_ssl_cert_login":                         m.MQTTSSLCertLogin,
+		"mqtt_max_session_expiry_interval_seconds":    m.MQTTMaxSessionExpiryIntervalSeconds,
+		"ssl_cert_login_from":                         m.SSLCertLoginFrom,
+		"ssl_options_fail_if_no_peer_cert":            m.SSLOptionsFailIfNoPeerCert,
+		"ssl_options_verify":                          m.SSLOptionsVerify,
+		"default_queue_type":                          m.DefaultQueueType,
+		"quorum_cluster_size":                         m.QuorumClusterSize,
+		"quorum_commands_soft_limit":                  m.QuorumCommandsSoftLimit,
+		"quorum_continuous_membership_reconciliation": m.QuorumContinuousMembershipReconciliation,
+		"stream_frame_max":                            m.StreamFrameMax,
+		"stream_heartbeat":                            m.StreamHeartbeat,
+		"vm_memory_high_watermark_paging_ratio":       m.VmMemoryHighWatermarkPagingRatio,
+		"disk_free_limit":                             m.DiskFreeLimit,
+		"log_default_level":                           m.LogDefaultLevel,
+		"log_connection_level":                        m.LogConnectionLevel,
+	}
+}
+
+// unsetWithoutDefault: attributes without a documented default not set in the configuration, either
+// the ones depending on the RabbitMQ version or the others, sorted by name.
+func (m rabbitMqConfigurationResourceModel) unsetWithoutDefault(versioned bool) []string {
+	var names []string
+	for name, value := range m.configurationValues() {
+		if _, ok := rabbitMqConfigurationDefaults[name]; ok || name == "mqtt_vhost" || !value.IsNull() {
+			continue
+		}
+		if _, ok := rabbitMqConfigurationVersions[name]; ok == versioned {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func addMissingManagedAttributeError(diags *diag.Diagnostics, name string) {
+	diags.AddAttributeError(path.Root(name), "Missing Configuration Attribute",
+		fmt.Sprintf("%s has no documented default value and must be set when manage_all is enabled", name))
+}
+
+// nullValue: a null value of the same type.
+func nullValue(value attr.Value) attr.Value {
+	switch value.(type) {
+	case types.Int64:
+		return types.Int64Null()
+	case types.Float64:
+		return types.Float64Null()
+	case types.Bool:
+		return types.BoolNull()
+	default:
+		return types.StringNull()
+	}
+}
+
 func (r *rabbitMqConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan rabbitMqConfigurationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -456,6 +809,22 @@ func (r *rabbitMqConfigurationResource) populateResourceModel(resourceModel *rab
 	resourceModel.SSLOptionsFailIfNoPeerCert = types.BoolValue(bool(data.SSLOptionsFailIfNoPeerCert))
 	resourceModel.SSLOptionsVerify = types.StringValue(data.SSLOptionsVerify)
 
+	// Queue defaults, stream settings, resource limits and log levels, null when not supported
+	resourceModel.DefaultQueueType = types.StringPointerValue(data.DefaultQueueType)
+	resourceModel.QuorumClusterSize = types.Int64PointerValue(data.QuorumClusterSize)
+	resourceModel.QuorumCommandsSoftLimit = types.Int64PointerValue(data.QuorumCommandsSoftLimit)
+	if data.QuorumContinuousMembershipReconciliation == nil {
+		resourceModel.QuorumContinuousMembershipReconciliation = types.BoolNull()
+	} else {
+		resourceModel.QuorumContinuousMembershipReconciliation = types.BoolValue(bool(*data.QuorumContinuousMembershipReconciliation))
+	}
+	resourceModel.StreamFrameMax = types.Int64PointerValue(data.StreamFrameMax)
+	resourceModel.StreamHeartbeat = types.Int64PointerValue(data.StreamHeartbeat)
+	resourceModel.VmMemoryHighWatermarkPagingRatio = types.Float64PointerValue(data.VmMemoryHighWatermarkPagingRatio)
+	resourceModel.DiskFreeLimit = types.Int64PointerValue(data.DiskFreeLimit)
+	resourceModel.LogDefaultLevel = types.StringPointerValue(data.LogDefaultLevel)
+	resourceModel.LogConnectionLevel = types.StringPointerValue(data.LogConnectionLevel)
+
 	// Handle special cases for pointer and custom types
 	if data.ConnectionMax == nil {
 		resourceModel.ConnectionMax = types.Int64Value(-1) // Default value when not set
@@ -572,6 +941,50 @@ func (r *rabbitMqConfigurationResource) populateCreateRequest(plan rabbitMqConfi
 		request.SSLOptionsVerify = plan.SSLOptionsVerify.ValueStringPointer()
 	}
 
+	// Queue defaults
+	if !plan.DefaultQueueType.IsUnknown() {
+		request.DefaultQueueType = plan.DefaultQueueType.ValueStringPointer()
+	}
+
+	if !plan.QuorumClusterSize.IsUnknown() {
+		request.QuorumClusterSize = plan.QuorumClusterSize.ValueInt64Pointer()
+	}
+
+	if !plan.QuorumCommandsSoftLimit.IsUnknown() {
+		request.QuorumCommandsSoftLimit = plan.QuorumCommandsSoftLimit.ValueInt64Pointer()
+	}
+
+	if !plan.QuorumContinuousMembershipReconciliation.IsUnknown() {
+		request.QuorumContinuousMembershipReconciliation = plan.QuorumContinuousMembershipReconciliation.ValueBoolPointer()
+	}
+
+	// Stream settings
+	if !plan.StreamFrameMax.IsUnknown() {
+		request.StreamFrameMax = plan.StreamFrameMax.ValueInt64Pointer()
+	}
+
+	if !plan.StreamHeartbeat.IsUnknown() {
+		request.StreamHeartbeat = plan.StreamHeartbeat.ValueInt64Pointer()
+	}
+
+	// Resource limits
+	if !plan.VmMemoryHighWatermarkPagingRatio.IsUnknown() {
+		request.VmMemoryHighWatermarkPagingRatio = plan.VmMemoryHighWatermarkPagingRatio.ValueFloat64Pointer()
+	}
+
+	if !plan.DiskFreeLimit.IsUnknown() {
+		request.DiskFreeLimit = plan.DiskFreeLimit.ValueInt64Pointer()
+	}
+
+	// Log levels
+	if !plan.LogDefaultLevel.IsUnknown() {
+		request.LogDefaultLevel = plan.LogDefaultLevel.ValueStringPointer()
+	}
+
+	if !plan.LogConnectionLevel.IsUnknown() {
+		request.LogConnectionLevel = plan.LogConnectionLevel.ValueStringPointer()
+	}
+
 	return request
 }
 
@@ -679,5 +1092,59 @@ func (r *rabbitMqConfigurationResource) populateUpdateRequest(plan, state rabbit
 		changed = true
 	}
 
+	// Queue defaults
+	if !plan.DefaultQueueType.IsNull() && !plan.DefaultQueueType.Equal(state.DefaultQueueType) {
+		request.DefaultQueueType = plan.DefaultQueueType.ValueStringPointer()
+		changed = true
+	}
+
+	if !plan.QuorumClusterSize.IsNull() && !plan.QuorumClusterSize.Equal(state.QuorumClusterSize) {
+		request.QuorumClusterSize = plan.QuorumClusterSize.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.QuorumCommandsSoftLimit.IsNull() && !plan.QuorumCommandsSoftLimit.Equal(state.QuorumCommandsSoftLimit) {
+		request.QuorumCommandsSoftLimit = plan.QuorumCommandsSoftLimit.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.QuorumContinuousMembershipReconciliation.IsNull() && !plan.QuorumContinuousMembershipReconciliation.Equal(state.QuorumContinuousMembershipReconciliation) {
+		request.QuorumContinuousMembershipReconciliation = plan.QuorumContinuousMembershipReconciliation.ValueBoolPointer()
+		changed = true
+	}
+
+	// Stream settings
+	if !plan.StreamFrameMax.IsNull() && !plan.StreamFrameMax.Equal(state.StreamFrameMax) {
+		request.StreamFrameMax = plan.StreamFrameMax.ValueInt64Pointer()
+		changed = true
+	}
+
+	if !plan.StreamHeartbeat.IsNull() && !plan.StreamHeartbeat.Equal(state.StreamHeartbeat) {
+		request.StreamHeartbeat = plan.StreamHeartbeat.ValueInt64Pointer()
+		changed = true
+	}
+
+	// Resource limits
+	if !plan.VmMemoryHighWatermarkPagingRatio.IsNull() && !plan.VmMemoryHighWatermarkPagingRatio.Equal(state.VmMemoryHighWatermarkPagingRatio) {
+		request.VmMemoryHighWatermarkPagingRatio = plan.VmMemoryHighWatermarkPagingRatio.ValueFloat64Pointer()
+		changed = true
+	}
+
+	if !plan.DiskFreeLimit.IsNull() && !plan.DiskFreeLimit.Equal(state.DiskFreeLimit) {
+		request.DiskFreeLimit = plan.DiskFreeLimit.ValueInt64Pointer()
+		changed = true
+	}
+
+	// Log levels
+	if !plan.LogDefaultLevel.IsNull() && !plan.LogDefaultLevel.Equal(state.LogDefaultLevel) {
+		request.LogDefaultLevel = plan.LogDefaultLevel.ValueStringPointer()
+		changed = true
+	}
+
+	if !plan.LogConnectionLevel.IsNull() && !plan.LogConnectionLevel.Equal(state.LogConnectionLevel) {
+		request.LogConnectionLevel = plan.LogConnectionLevel.ValueStringPointer()
+		changed = true
+	}
+
 	return request, changed
 }
@@ -1,10 +1,14 @@
 package cloudamqp
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance/configuration"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -390,3 +394,317 @@ func TestAccRabbitMqConfiguration_InvalidValues(t *testing.T) {
 		},
 	})
 }
+
+// TestAccRabbitMqConfiguration_AdvancedSettings: Queue defaults, stream settings, resource limits and
+// log levels, update and import.
+func TestAccRabbitMqConfiguration_AdvancedSettings(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		instanceID                 = fakeAPIInstance(t, server)
+		rabbitMqConfigResourceName = "cloudamqp_rabbitmq_configuration.rabbitmq_config"
+	)
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+						instance_id         = %d
+						default_queue_type  = "quorum"
+						quorum_cluster_size = 5
+						stream_heartbeat    = 30
+						disk_free_limit     = 100000000
+						log_default_level   = "warning"
+					}`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "default_queue_type", "quorum"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "quorum_cluster_size", "5"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "quorum_commands_soft_limit", "32"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "quorum_continuous_membership_reconciliation", "false"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "stream_frame_max", "1048576"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "stream_heartbeat", "30"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "disk_free_limit", "100000000"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "log_default_level", "warning"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "log_connection_level", "info"),
+					resource.TestCheckNoResourceAttr(rabbitMqConfigResourceName, "vm_memory_high_watermark_paging_ratio"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+						instance_id                                 = %d
+						default_queue_type                          = "classic"
+						quorum_cluster_size                         = 5
+						quorum_continuous_membership_reconciliation = true
+						stream_heartbeat                            = 30
+						disk_free_limit                             = 100000000
+						log_default_level                           = "warning"
+						log_connection_level                        = "error"
+					}`, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "default_queue_type", "classic"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "quorum_continuous_membership_reconciliation", "true"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "log_connection_level", "error"),
+				),
+			},
+			{
+				ResourceName:            rabbitMqConfigResourceName,
+				ImportStateId:           fmt.Sprint(instanceID),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sleep", "timeout"},
+			},
+		},
+	})
+}
+
+// TestAccRabbitMqConfiguration_UnsupportedVersion: Reject settings not supported by the RabbitMQ
+// version of the instance.
+func TestAccRabbitMqConfiguration_UnsupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	data, err := server.API().CreateInstance(context.Background(), map[string]any{
+		"name":        t.Name(),
+		"plan":        "bunny-1",
+		"region":      "amazon-web-services::us-east-1",
+		"rmq_version": "3.12.13",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		instanceID                 = fakeAPIInstance(t, server)
+		rabbitMqConfigResourceName = "cloudamqp_rabbitmq_configuration.rabbitmq_config"
+	)
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+						instance_id                                 = %v
+						default_queue_type                          = "quorum"
+						quorum_continuous_membership_reconciliation = true
+					}`, data["id"]),
+				PlanOnly: true,
+				ExpectError: regexp.MustCompile(`(?s)default_queue_type requires RabbitMQ 3.13 or later, instance\s+\d+\s+runs\s+3.12.13` +
+					`.*quorum_continuous_membership_reconciliation requires RabbitMQ 3.13 or later`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+						instance_id                           = %d
+						vm_memory_high_watermark_paging_ratio = 0.6
+					}`, instanceID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`vm_memory_high_watermark_paging_ratio is not supported from RabbitMQ\s+4.0,\s+instance\s+\d+\s+runs\s+4.1.6`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+						instance_id                           = %v
+						vm_memory_high_watermark_paging_ratio = 0.6
+					}`, data["id"]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "vm_memory_high_watermark_paging_ratio", "0.6"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "stream_frame_max", "1048576"),
+					resource.TestCheckNoResourceAttr(rabbitMqConfigResourceName, "default_queue_type"),
+					resource.TestCheckNoResourceAttr(rabbitMqConfigResourceName, "quorum_continuous_membership_reconciliation"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccRabbitMqConfiguration_ManageAll: Attributes not set are reset to documented default values,
+// attributes without a documented default must be set and changes made outside of Terraform are
+// shown as drift, only with manage_all enabled.
+func TestAccRabbitMqConfiguration_ManageAll(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	var (
+		instanceID                 = fakeAPIInstance(t, server)
+		rabbitMqConfigResourceName = "cloudamqp_rabbitmq_configuration.rabbitmq_config"
+		changeChannelMax           = func() {
+			err := server.API().UpdateRabbitMqConfiguration(context.Background(), instanceID,
+				model.RabbitMqConfigRequest{ChannelMax: utils.Pointer(int64(64))}, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		changeQuorumClusterSize = func() {
+			err := server.API().UpdateRabbitMqConfiguration(context.Background(), instanceID,
+				model.RabbitMqConfigRequest{QuorumClusterSize: utils.Pointer(int64(5))}, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		manageAllConfig = func(versioned string) string {
+			return fmt.Sprintf(`
+				resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+					instance_id                              = %d
+					heartbeat                                = 60
+					cluster_partition_handling               = "autoheal"
+					message_interceptors_timestamp_overwrite = "disabled"
+					mqtt_exchange                            = "amq.topic"
+					mqtt_ssl_cert_login                      = false
+					ssl_cert_login_from                      = "distinguished_name"
+					ssl_options_fail_if_no_peer_cert         = false
+					ssl_options_verify                       = "verify_none"
+					quorum_cluster_size                      = 3
+					quorum_commands_soft_limit               = 32
+					disk_free_limit                          = 50000000
+					log_default_level                        = "info"
+					log_connection_level                     = "info"
+					%s
+					manage_all                               = true
+				}`, instanceID, versioned)
+		}
+		versionedConfig = `
+					default_queue_type                          = "classic"
+					quorum_continuous_membership_reconciliation = false
+					stream_frame_max                            = 1048576
+					stream_heartbeat                            = 60`
+		config = fmt.Sprintf(`
+			resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+				instance_id = %d
+				heartbeat   = 60
+			}`, instanceID)
+	)
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+						instance_id = %d
+						heartbeat   = 60
+						manage_all  = true
+					}`, instanceID),
+				PlanOnly: true,
+				ExpectError: regexp.MustCompile(`(?s)cluster_partition_handling has no documented default value and must be` +
+					`.*quorum_cluster_size has no documented default value and must be set when\s+manage_all is enabled`),
+			},
+			{
+				Config:      manageAllConfig(""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)default_queue_type has no documented default value.*stream_heartbeat has no`),
+			},
+			{
+				PreConfig: changeChannelMax,
+				Config:    manageAllConfig(versionedConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "heartbeat", "60"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "channel_max", "128"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "mqtt_vhost", fmt.Sprintf("fake%d", instanceID)),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "mqtt_max_session_expiry_interval_seconds", "1800"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "quorum_cluster_size", "3"),
+					resource.TestCheckNoResourceAttr(rabbitMqConfigResourceName, "vm_memory_high_watermark_paging_ratio"),
+				),
+			},
+			{
+				PreConfig:          changeQuorumClusterSize,
+				Config:             manageAllConfig(versionedConfig),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: manageAllConfig(versionedConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "quorum_cluster_size", "3"),
+				),
+			},
+			{
+				PreConfig:          changeChannelMax,
+				Config:             manageAllConfig(versionedConfig),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: manageAllConfig(versionedConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "channel_max", "128"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(rabbitMqConfigResourceName, "manage_all"),
+				),
+			},
+			{
+				PreConfig: changeChannelMax,
+				Config:    config,
+				PlanOnly:  true,
+			},
+		},
+	})
+}
+
+// TestAccRabbitMqConfiguration_ManageAllVersion: Only default values and required attributes
+// supported by the RabbitMQ version of the instance apply, mqtt_max_session_expiry_interval_seconds
+// and default_queue_type require 3.13.
+func TestAccRabbitMqConfiguration_ManageAllVersion(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	data, err := server.API().CreateInstance(context.Background(), map[string]any{
+		"name":        t.Name(),
+		"plan":        "bunny-1",
+		"region":      "amazon-web-services::us-east-1",
+		"rmq_version": "3.12.13",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rabbitMqConfigResourceName := "cloudamqp_rabbitmq_configuration.rabbitmq_config"
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_rabbitmq_configuration" "rabbitmq_config" {
+						instance_id                              = %v
+						cluster_partition_handling               = "autoheal"
+						message_interceptors_timestamp_overwrite = "disabled"
+						mqtt_exchange                            = "amq.topic"
+						mqtt_ssl_cert_login                      = false
+						ssl_cert_login_from                      = "distinguished_name"
+						ssl_options_fail_if_no_peer_cert         = false
+						ssl_options_verify                       = "verify_none"
+						quorum_cluster_size                      = 3
+						quorum_commands_soft_limit               = 32
+						stream_frame_max                         = 1048576
+						stream_heartbeat                         = 60
+						vm_memory_high_watermark_paging_ratio    = 0.5
+						disk_free_limit                          = 50000000
+						log_default_level                        = "info"
+						log_connection_level                     = "info"
+						manage_all                               = true
+					}`, data["id"]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "heartbeat", "120"),
+					resource.TestCheckResourceAttr(rabbitMqConfigResourceName, "vm_memory_high_watermark_paging_ratio", "0.5"),
+					resource.TestCheckNoResourceAttr(rabbitMqConfigResourceName, "default_queue_type"),
+					resource.TestCheckNoResourceAttr(rabbitMqConfigResourceName, "mqtt_max_session_expiry_interval_seconds"),
+				),
+			},
+		},
+	})
+}
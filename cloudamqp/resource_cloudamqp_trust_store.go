@@ -9,9 +9,12 @@ import (
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
 	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/instance/configuration"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,11 +30,16 @@ import (
 )
 
 var (
-	_ resource.Resource                = &trustStoreResource{}
-	_ resource.ResourceWithConfigure   = &trustStoreResource{}
-	_ resource.ResourceWithImportState = &trustStoreResource{}
+	_ resource.Resource                   = &trustStoreResource{}
+	_ resource.ResourceWithConfigure      = &trustStoreResource{}
+	_ resource.ResourceWithImportState    = &trustStoreResource{}
+	_ resource.ResourceWithValidateConfig = &trustStoreResource{}
+	_ resource.ResourceWithModifyPlan     = &trustStoreResource{}
 )
 
+// trustStoreExpiryWarningDays: default window to warn about expiring certificates.
+const trustStoreExpiryWarningDays = 30
+
 type trustStoreResource struct {
 	client *api.API
 }
@@ -50,6 +58,7 @@ type trustStoreResourceModel struct {
 	KeyID           types.String         `tfsdk:"key_id"`
 	Sleep           types.Int64          `tfsdk:"sleep"`
 	Timeout         types.Int64          `tfsdk:"timeout"`
+	ExpiryWarning   types.Int64          `tfsdk:"expiry_warning_days"`
 }
 
 type httpTrustStoreBlock struct {
@@ -62,8 +71,11 @@ type fileTrustStoreBlock struct {
 }
 
 type fileTrustStoreCertificate struct {
-	Name    types.String `tfsdk:"name"`
-	Content types.String `tfsdk:"content"`
+	Name        types.String `tfsdk:"name"`
+	Content     types.String `tfsdk:"content"`
+	NotAfter    types.String `tfsdk:"not_after"`
+	Subject     types.String `tfsdk:"subject"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
 }
 
 func (r *trustStoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -132,6 +144,15 @@ func (r *trustStoreResource) Schema(ctx context.Context, req resource.SchemaRequ
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"expiry_warning_days": schema.Int64Attribute{
+				Optional:    true,
+				Default:     int64default.StaticInt64(trustStoreExpiryWarningDays),
+				Computed:    true,
+				Description: "Warn when a certificate expires within this number of days",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"http": schema.SingleNestedBlock{
@@ -175,6 +196,18 @@ func (r *trustStoreResource) Schema(ctx context.Context, req resource.SchemaRequ
 									WriteOnly:   true,
 									Description: "PEM encoded certificate content",
 								},
+								"not_after": schema.StringAttribute{
+									Computed:    true,
+									Description: "Expiration time of the certificate in RFC3339 format",
+								},
+								"subject": schema.StringAttribute{
+									Computed:    true,
+									Description: "Subject of the certificate",
+								},
+								"fingerprint": schema.StringAttribute{
+									Computed:    true,
+									Description: "SHA-256 fingerprint of the certificate",
+								},
 							},
 						},
 						PlanModifiers: []planmodifier.List{
@@ -224,6 +257,70 @@ func (r *trustStoreResource) ImportState(ctx context.Context, req resource.Impor
 	resp.State.SetAttribute(ctx, path.Root("key_id"), "")
 	resp.State.SetAttribute(ctx, path.Root("sleep"), 10)
 	resp.State.SetAttribute(ctx, path.Root("timeout"), 1800)
+	resp.State.SetAttribute(ctx, path.Root("expiry_warning_days"), trustStoreExpiryWarningDays)
+}
+
+func (r *trustStoreResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config trustStoreResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ExpiryWarning.IsUnknown() {
+		return
+	}
+
+	window := time.Duration(trustStoreExpiryWarningDays) * 24 * time.Hour
+	if !config.ExpiryWarning.IsNull() {
+		window = time.Duration(config.ExpiryWarning.ValueInt64()) * 24 * time.Hour
+	}
+
+	if config.Http != nil {
+		resp.Diagnostics.Append(validateTrustStoreCertificates(path.Root("http").AtName("cacert"),
+			config.Http.Cacert, window, true)...)
+	}
+	if config.File != nil {
+		for i, certificate := range config.File.Certificates {
+			p := path.Root("file").AtName("certificates").AtListIndex(i).AtName("content")
+			resp.Diagnostics.Append(validateTrustStoreCertificates(p, certificate.Content, window, false)...)
+		}
+	}
+}
+
+// ModifyPlan: computes the certificate attributes from the write only content, only available in
+// the configuration.
+func (r *trustStoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan trustStoreResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || config.File == nil || plan.File == nil ||
+		len(config.File.Certificates) != len(plan.File.Certificates) {
+		return
+	}
+
+	for i, certificate := range config.File.Certificates {
+		planned := &plan.File.Certificates[i]
+		switch {
+		case certificate.Content.IsUnknown():
+			continue
+		case certificate.Content.IsNull():
+			planned.NotAfter = types.StringNull()
+			planned.Subject = types.StringNull()
+			planned.Fingerprint = types.StringNull()
+			continue
+		}
+
+		certificates, err := utils.ParsePEMCertificates(certificate.Content.ValueString())
+		if err != nil {
+			// Reported by ValidateConfig
+			continue
+		}
+		planned.NotAfter = types.StringValue(certificates[0].NotAfter.UTC().Format(time.RFC3339))
+		planned.Subject = types.StringValue(certificates[0].Subject.String())
+		planned.Fingerprint = types.StringValue(utils.CertificateFingerprint(certificates[0]))
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *trustStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -438,5 +535,59 @@ func (r *trustStoreResource) shouldUpdateWriteOnly(plan, state *trustStoreResour
 		return true
 	}
 
+	// Changed certificate content, only known for certificates with a fingerprint in the state
+	if plan.File != nil && state.File != nil {
+		if len(plan.File.Certificates) != len(state.File.Certificates) {
+			return true
+		}
+		for i, certificate := range state.File.Certificates {
+			if certificate.Fingerprint.IsNull() || certificate.Fingerprint.IsUnknown() {
+				continue
+			}
+			if !plan.File.Certificates[i].Fingerprint.Equal(certificate.Fingerprint) {
+				return true
+			}
+		}
+	}
+
 	return false
 }
+
+// validateTrustStoreCertificates: parses the PEM encoded certificates, with a warning for
+// certificates expiring within the window. Leaf certificates are accepted for the file provider,
+// used as an allow list of client certificates.
+func validateTrustStoreCertificates(p path.Path, value types.String, window time.Duration,
+	requireCA bool) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	certificates, err := utils.ParsePEMCertificates(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Certificate",
+			fmt.Sprintf("Failed to parse PEM encoded certificate: %s", err))
+		return diags
+	}
+
+	now := time.Now()
+	for _, certificate := range certificates {
+		subject := certificate.Subject.String()
+		if requireCA && !certificate.IsCA {
+			diags.AddAttributeError(p, "Invalid Certificate",
+				fmt.Sprintf("Certificate %q is not a CA certificate", subject))
+			continue
+		}
+		notAfter := certificate.NotAfter.UTC().Format(time.RFC3339)
+		switch {
+		case now.After(certificate.NotAfter):
+			diags.AddAttributeWarning(p, "Certificate Expired",
+				fmt.Sprintf("Certificate %q expired at %s", subject, notAfter))
+		case now.Add(window).After(certificate.NotAfter):
+			diags.AddAttributeWarning(p, "Certificate Expires Soon",
+				fmt.Sprintf("Certificate %q expires at %s", subject, notAfter))
+		}
+	}
+	return diags
+}
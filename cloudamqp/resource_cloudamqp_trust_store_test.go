@@ -5,8 +5,14 @@ import (
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // Extra environment variables needed to record this test. Used self signed certificates and loaded
@@ -68,8 +74,10 @@ func TestAccTrustStore_HttpWithCaVersion(t *testing.T) {
 
 	trustStoreResourceName := "cloudamqp_trust_store.trust_store"
 
-	// Set sanitized value for playback and use real value for recording
-	testTrustStoreCA := "TEST_TRUST_STORE_CA"
+	// Set generated CA certificate for playback and use real value for recording, request bodies
+	// are not matched against the cassette.
+	testTrustStoreCA := testHCLString(testCertificate(t, testCertificateOptions{
+		CommonName: "TEST_TRUST_STORE_CA", IsCA: true}).CertificatePEM)
 	if os.Getenv("CLOUDAMQP_RECORD") != "" {
 		testTrustStoreCA = os.Getenv("TEST_TRUST_STORE_CA")
 	}
@@ -133,8 +141,10 @@ func TestAccTrustStore_HttpWithCaKeyID(t *testing.T) {
 
 	trustStoreResourceName := "cloudamqp_trust_store.trust_store"
 
-	// Set sanitized value for playback and use real value for recording
-	testTrustStoreCA := "TEST_TRUST_STORE_CA"
+	// Set generated CA certificate for playback and use real value for recording, request bodies
+	// are not matched against the cassette.
+	testTrustStoreCA := testHCLString(testCertificate(t, testCertificateOptions{
+		CommonName: "TEST_TRUST_STORE_CA", IsCA: true}).CertificatePEM)
 	if os.Getenv("CLOUDAMQP_RECORD") != "" {
 		testTrustStoreCA = os.Getenv("TEST_TRUST_STORE_CA")
 	}
@@ -198,9 +208,12 @@ func TestAccTrustStore_FileVersion(t *testing.T) {
 
 	trustStoreResourceName := "cloudamqp_trust_store.trust_store"
 
-	// Set sanitized value for playback and use real value for recording
-	testTrustStoreCert := "TEST_TRUST_STORE_CERT"
-	testTrustStoreCert_02 := "TEST_TRUST_STORE_CERT_2"
+	// Set generated CA certificates for playback and use real value for recording, request bodies
+	// are not matched against the cassette.
+	testTrustStoreCert := testHCLString(testCertificate(t, testCertificateOptions{
+		CommonName: "TEST_TRUST_STORE_CERT", IsCA: true}).CertificatePEM)
+	testTrustStoreCert_02 := testHCLString(testCertificate(t, testCertificateOptions{
+		CommonName: "TEST_TRUST_STORE_CERT_2", IsCA: true}).CertificatePEM)
 	if os.Getenv("CLOUDAMQP_RECORD") != "" {
 		testTrustStoreCert = os.Getenv("TEST_TRUST_STORE_CERT")
 		testTrustStoreCert_02 = os.Getenv("TEST_TRUST_STORE_CERT_2")
@@ -279,9 +292,12 @@ func TestAccTrustStore_FileKeyID(t *testing.T) {
 
 	trustStoreResourceName := "cloudamqp_trust_store.trust_store"
 
-	// Set sanitized value for playback and use real value for recording
-	testTrustStoreCert := "TEST_TRUST_STORE_CERT"
-	testTrustStoreCert_02 := "TEST_TRUST_STORE_CERT_2"
+	// Set generated CA certificates for playback and use real value for recording, request bodies
+	// are not matched against the cassette.
+	testTrustStoreCert := testHCLString(testCertificate(t, testCertificateOptions{
+		CommonName: "TEST_TRUST_STORE_CERT", IsCA: true}).CertificatePEM)
+	testTrustStoreCert_02 := testHCLString(testCertificate(t, testCertificateOptions{
+		CommonName: "TEST_TRUST_STORE_CERT_2", IsCA: true}).CertificatePEM)
 	if os.Getenv("CLOUDAMQP_RECORD") != "" {
 		testTrustStoreCert = os.Getenv("TEST_TRUST_STORE_CERT")
 		testTrustStoreCert_02 = os.Getenv("TEST_TRUST_STORE_CERT_2")
@@ -380,3 +396,160 @@ func TestAccTrustStore_MissingProvider(t *testing.T) {
 		},
 	})
 }
+
+// TestAccTrustStore_FileCertificates: Parse file certificates at plan time, compute certificate
+// attributes and update the trust store when the certificate content changes.
+func TestAccTrustStore_FileCertificates(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+
+	var (
+		trustStoreResourceName = "cloudamqp_trust_store.trust_store"
+		rootCA                 = testCertificate(t, testCertificateOptions{CommonName: "Root CA", IsCA: true})
+		intermediateCA         = testCertificate(t, testCertificateOptions{CommonName: "Intermediate CA",
+			IsCA: true, Issuer: rootCA})
+		rotatedCA = testCertificate(t, testCertificateOptions{CommonName: "Rotated CA", IsCA: true})
+		leaf      = testCertificate(t, testCertificateOptions{CommonName: "leaf.example.com", Issuer: rootCA})
+	)
+
+	config := func(first, second string) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_trust_store" "trust_store" {
+				instance_id = %d
+				sleep       = 1
+				file {
+					certificates {
+						name    = "root"
+						content = "%s"
+					}
+					certificates {
+						name    = "intermediate"
+						content = "%s"
+					}
+				}
+			}`, instanceID, testHCLString(first), testHCLString(second))
+	}
+
+	updates := func(expected int) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			count := 0
+			for _, request := range server.Requests() {
+				if request == fmt.Sprintf("PUT /api/instances/%d/trust-store-configuration", instanceID) {
+					count++
+				}
+			}
+			if count != expected {
+				return fmt.Errorf("expected %d trust store updates, got %d", expected, count)
+			}
+			return nil
+		}
+	}
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      config("not a certificate", leaf.CertificatePEM),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Failed to parse PEM encoded certificate: malformed PEM data`),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_trust_store" "trust_store" {
+						instance_id = %d
+						http {
+							url    = "https://trust-store.example.com/certs"
+							cacert = "%s"
+						}
+					}`, instanceID, testHCLString(leaf.CertificatePEM)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Certificate "CN=leaf.example.com" is not a CA certificate`),
+			},
+			{
+				Config: config(rootCA.CertificatePEM, intermediateCA.CertificatePEM),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(trustStoreResourceName, "expiry_warning_days", "30"),
+					resource.TestCheckResourceAttr(trustStoreResourceName, "file.certificates.0.subject", "CN=Root CA"),
+					resource.TestCheckResourceAttr(trustStoreResourceName, "file.certificates.0.not_after",
+						rootCA.Certificate.NotAfter.UTC().Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(trustStoreResourceName, "file.certificates.0.fingerprint",
+						utils.CertificateFingerprint(rootCA.Certificate)),
+					resource.TestCheckResourceAttr(trustStoreResourceName, "file.certificates.1.subject",
+						"CN=Intermediate CA"),
+					updates(0),
+				),
+			},
+			{
+				Config: config(rootCA.CertificatePEM, leaf.CertificatePEM),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(trustStoreResourceName, "version", "1"),
+					resource.TestCheckResourceAttr(trustStoreResourceName, "file.certificates.1.subject",
+						"CN=leaf.example.com"),
+					updates(1),
+				),
+			},
+			{
+				Config: config(rootCA.CertificatePEM, rotatedCA.CertificatePEM),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(trustStoreResourceName, "version", "1"),
+					resource.TestCheckResourceAttr(trustStoreResourceName, "file.certificates.1.subject", "CN=Rotated CA"),
+					resource.TestCheckResourceAttr(trustStoreResourceName, "file.certificates.1.fingerprint",
+						utils.CertificateFingerprint(rotatedCA.Certificate)),
+					updates(2),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateTrustStoreCertificates(t *testing.T) {
+	var (
+		p        = path.Root("http").AtName("cacert")
+		window   = 30 * 24 * time.Hour
+		valid    = testCertificate(t, testCertificateOptions{CommonName: "Valid CA", IsCA: true})
+		expiring = testCertificate(t, testCertificateOptions{CommonName: "Expiring CA", IsCA: true,
+			NotAfter: time.Now().AddDate(0, 0, 10)})
+		expired = testCertificate(t, testCertificateOptions{CommonName: "Expired CA", IsCA: true,
+			NotAfter: time.Now().Add(-time.Minute)})
+		leaf = testCertificate(t, testCertificateOptions{CommonName: "leaf.example.com", Issuer: valid})
+	)
+
+	tests := []struct {
+		content   string
+		requireCA bool
+		errors    int
+		warnings  []string
+	}{
+		{valid.CertificatePEM, true, 0, nil},
+		{valid.CertificatePEM + expiring.CertificatePEM, true, 0, []string{"Certificate Expires Soon"}},
+		{expired.CertificatePEM, true, 0, []string{"Certificate Expired"}},
+		{leaf.CertificatePEM, true, 1, nil},
+		{leaf.CertificatePEM, false, 0, nil},
+		{"-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n", false, 1, nil},
+	}
+
+	for _, tc := range tests {
+		diags := validateTrustStoreCertificates(p, types.StringValue(tc.content), window, tc.requireCA)
+		if diags.ErrorsCount() != tc.errors {
+			t.Errorf("expected %d errors, got %v", tc.errors, diags.Errors())
+		}
+		warnings := diags.Warnings()
+		if len(warnings) != len(tc.warnings) {
+			t.Errorf("expected warnings %v, got %v", tc.warnings, warnings)
+			continue
+		}
+		for i, warning := range warnings {
+			if warning.Summary() != tc.warnings[i] {
+				t.Errorf("expected warning %q, got %q", tc.warnings[i], warning.Summary())
+			}
+		}
+	}
+
+	diags := validateTrustStoreCertificates(p, types.StringValue(expiring.CertificatePEM), 0, true)
+	if diags.WarningsCount() != 0 {
+		t.Errorf("expected no warnings without window, got %v", diags.Warnings())
+	}
+}
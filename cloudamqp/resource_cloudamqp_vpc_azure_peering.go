@@ -0,0 +1,314 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &vpcAzurePeeringResource{}
+	_ resource.ResourceWithConfigure        = &vpcAzurePeeringResource{}
+	_ resource.ResourceWithConfigValidators = &vpcAzurePeeringResource{}
+	_ resource.ResourceWithImportState      = &vpcAzurePeeringResource{}
+)
+
+type vpcAzurePeeringResource struct {
+	client *api.API
+}
+
+func NewVpcAzurePeeringResource() resource.Resource {
+	return &vpcAzurePeeringResource{}
+}
+
+type vpcAzurePeeringResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	InstanceID          types.Int64  `tfsdk:"instance_id"`
+	VpcID               types.String `tfsdk:"vpc_id"`
+	SubscriptionID      types.String `tfsdk:"subscription_id"`
+	ResourceGroup       types.String `tfsdk:"resource_group"`
+	VnetName            types.String `tfsdk:"vnet_name"`
+	WaitOnPeeringStatus types.Bool   `tfsdk:"wait_on_peering_status"`
+	Status              types.String `tfsdk:"status"`
+	StatusMessage       types.String `tfsdk:"status_message"`
+	RemoteVnetID        types.String `tfsdk:"remote_vnet_id"`
+	Sleep               types.Int64  `tfsdk:"sleep"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *vpcAzurePeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_vpc_azure_peering"
+}
+
+func (r *vpcAzurePeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Request a VNet peering to an Azure virtual network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The VNet peering name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Instance identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"vpc_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "VPC instance identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Azure subscription identifier of the virtual network to peer with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_group": schema.StringAttribute{
+				Required:    true,
+				Description: "Azure resource group of the virtual network to peer with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vnet_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the Azure virtual network to peer with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_on_peering_status": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Wait until peering status change to 'Connected'",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "VNet peering status",
+			},
+			"status_message": schema.StringAttribute{
+				Computed:    true,
+				Description: "VNet peering status message",
+			},
+			"remote_vnet_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Azure resource identifier of the peered virtual network",
+			},
+			"sleep": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10),
+				Description: "Configurable sleep in seconds between retries when requesting or reading peering",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1800),
+				Description: "Configurable timeout time (seconds) before retries times out",
+			},
+		},
+	}
+}
+
+func (r *vpcAzurePeeringResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("instance_id"),
+			path.MatchRoot("vpc_id"),
+		),
+	}
+}
+
+func (r *vpcAzurePeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *vpcAzurePeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, vpcID, peeringID, err := parseVpcPeeringImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID format", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), peeringID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_on_peering_status"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sleep"), int64(10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(1800))...)
+	if vpcID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_id"), vpcID)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	}
+}
+
+func (r *vpcAzurePeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcAzurePeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		instanceID = plan.InstanceID.ValueInt64()
+		vpcID      = plan.VpcID.ValueString()
+		sleep      = int(plan.Sleep.ValueInt64())
+		timeout    = int(plan.Timeout.ValueInt64())
+		params     = model.AzurePeeringRequest{
+			SubscriptionID: plan.SubscriptionID.ValueString(),
+			ResourceGroup:  plan.ResourceGroup.ValueString(),
+			VnetName:       plan.VnetName.ValueString(),
+		}
+	)
+
+	peeringID, err := r.client.RequestVpcAzurePeering(ctx, instanceID, vpcID, params,
+		plan.WaitOnPeeringStatus.ValueBool(), sleep, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to request VNet peering",
+			fmt.Sprintf("Could not request VNet peering to %s: %s", params.VnetName, err),
+		)
+		return
+	}
+
+	data, err := r.client.ReadVpcAzurePeering(ctx, instanceID, vpcID, peeringID, sleep, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read VNet peering",
+			fmt.Sprintf("Could not read VNet peering %s: %s", peeringID, err),
+		)
+		return
+	}
+	if data == nil {
+		resp.Diagnostics.AddError(
+			"VNet peering not found",
+			fmt.Sprintf("VNet peering %s could not be found after being requested", peeringID),
+		)
+		return
+	}
+
+	r.populateResourceModel(*data, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcAzurePeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcAzurePeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peeringID := state.ID.ValueString()
+	data, err := r.client.ReadVpcAzurePeering(ctx, state.InstanceID.ValueInt64(), state.VpcID.ValueString(),
+		peeringID, int(state.Sleep.ValueInt64()), int(state.Timeout.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read VNet peering",
+			fmt.Sprintf("Could not read VNet peering %s: %s", peeringID, err),
+		)
+		return
+	}
+
+	// Resource drift: peering not found, trigger re-creation
+	if data == nil {
+		tflog.Info(ctx, fmt.Sprintf("VNet peering not found, resource will be recreated: %s", peeringID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.populateResourceModel(*data, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vpcAzurePeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcAzurePeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only wait_on_peering_status, sleep and timeout can be updated, keep the peering status from the state.
+	plan.Status = state.Status
+	plan.StatusMessage = state.StatusMessage
+	plan.RemoteVnetID = state.RemoteVnetID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcAzurePeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcAzurePeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peeringID := state.ID.ValueString()
+	err := r.client.RemoveVpcAzurePeering(ctx, state.InstanceID.ValueInt64(), state.VpcID.ValueString(),
+		peeringID, int(state.Sleep.ValueInt64()), int(state.Timeout.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove VNet peering",
+			fmt.Sprintf("Could not remove VNet peering %s: %s", peeringID, err),
+		)
+	}
+}
+
+func (r *vpcAzurePeeringResource) populateResourceModel(data model.AzurePeeringResponse,
+	state *vpcAzurePeeringResourceModel) {
+
+	populatePeeringStatus(data.Peering(), &state.ID, &state.Status, &state.StatusMessage)
+	state.RemoteVnetID = types.StringValue(data.RemoteVirtualNetworkID)
+	if subscriptionID, resourceGroup, vnetName, ok := parseAzureVnetID(data.RemoteVirtualNetworkID); ok {
+		state.SubscriptionID = types.StringValue(subscriptionID)
+		state.ResourceGroup = types.StringValue(resourceGroup)
+		state.VnetName = types.StringValue(vnetName)
+	}
+}
+
+// parseAzureVnetID: splits an Azure virtual network resource identifier,
+// /subscriptions/<id>/resourceGroups/<group>/providers/Microsoft.Network/virtualNetworks/<name>
+func parseAzureVnetID(id string) (string, string, string, bool) {
+	parts := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(parts) != 8 ||
+		!strings.EqualFold(parts[0], "subscriptions") ||
+		!strings.EqualFold(parts[2], "resourceGroups") ||
+		!strings.EqualFold(parts[4], "providers") ||
+		!strings.EqualFold(parts[6], "virtualNetworks") {
+		return "", "", "", false
+	}
+	return parts[1], parts[3], parts[7], true
+}
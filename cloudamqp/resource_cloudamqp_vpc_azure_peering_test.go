@@ -0,0 +1,63 @@
+package cloudamqp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccVpcAzurePeering_Basic: Request a VNet peering, wait for it to be connected and import it.
+func TestAccVpcAzurePeering_Basic(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+
+	vpc, err := server.API().CreateVPC(context.Background(), model.VpcRequest{
+		Name:   t.Name(),
+		Region: "azure-arm::westeurope",
+		Subnet: "10.56.72.0/24",
+		Tags:   []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	peeringResourceName := "cloudamqp_vpc_azure_peering.vpc_peering"
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "cloudamqp_vpc_azure_peering" "vpc_peering" {
+						vpc_id                 = "%d"
+						subscription_id        = "00000000-0000-0000-0000-000000000000"
+						resource_group         = "rg-test"
+						vnet_name              = "vnet-test"
+						wait_on_peering_status = true
+						sleep                  = 1
+					}
+				`, vpc.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(peeringResourceName, "id", "cloudamqp-to-vnet-test"),
+					resource.TestCheckResourceAttr(peeringResourceName, "status", "Connected"),
+					resource.TestCheckResourceAttr(peeringResourceName, "status_message", "FullyInSync"),
+					resource.TestCheckResourceAttr(peeringResourceName, "remote_vnet_id",
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-test/providers/Microsoft.Network/virtualNetworks/vnet-test"),
+				),
+			},
+			{
+				ResourceName:            peeringResourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%d,cloudamqp-to-vnet-test", vpc.ID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_on_peering_status", "sleep"},
+			},
+		},
+	})
+}
@@ -2,16 +2,28 @@ package cloudamqp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var (
@@ -20,246 +32,539 @@ var (
 	GCP_PROJECT_ID_VALIDATE_RE, _ = regexp.Compile(`\A[a-z][0-9a-z-]{4,28}[0-9a-z]\z`)
 )
 
-func resourceVpcConnect() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceVpcConnectCreate,
-		ReadContext:   resourceVpcConnectRead,
-		UpdateContext: resourceVpcConnectUpdate,
-		DeleteContext: resourceVpcConnectDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"instance_id": {
-				Type:        schema.TypeInt,
+var (
+	_ resource.Resource                   = &vpcConnectResource{}
+	_ resource.ResourceWithConfigure      = &vpcConnectResource{}
+	_ resource.ResourceWithImportState    = &vpcConnectResource{}
+	_ resource.ResourceWithModifyPlan     = &vpcConnectResource{}
+	_ resource.ResourceWithMoveState      = &vpcConnectResource{}
+	_ resource.ResourceWithValidateConfig = &vpcConnectResource{}
+)
+
+// vpcConnectAllowlists: allowlist attribute used by each platform.
+var vpcConnectAllowlists = map[string]string{
+	"amazon": "allowed_principals",
+	"azure":  "approved_subscriptions",
+	"google": "allowed_projects",
+}
+
+type vpcConnectResource struct {
+	client *api.API
+}
+
+func NewVpcConnectResource() resource.Resource {
+	return &vpcConnectResource{}
+}
+
+type vpcConnectResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	InstanceID            types.Int64  `tfsdk:"instance_id"`
+	Region                types.String `tfsdk:"region"`
+	AllowedPrincipals     types.List   `tfsdk:"allowed_principals"`
+	ApprovedSubscriptions types.List   `tfsdk:"approved_subscriptions"`
+	AllowedProjects       types.List   `tfsdk:"allowed_projects"`
+	ActiveZones           types.List   `tfsdk:"active_zones"`
+	Status                types.String `tfsdk:"status"`
+	ServiceName           types.String `tfsdk:"service_name"`
+	ServerName            types.String `tfsdk:"server_name"`
+	Sleep                 types.Int64  `tfsdk:"sleep"`
+	Timeout               types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *vpcConnectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_vpc_connect"
+}
+
+func (r *vpcConnectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enable VPC Connect (PrivateLink or Private Service Connect) for an instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier for this resource, same as the instance identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
 				Required:    true,
-				ForceNew:    true,
 				Description: "The CloudAMQP instance identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
-			"region": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The region where the CloudAMQP instance is hosted",
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the CloudAMQP instance is hosted, read from the instance if not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"allowed_principals": {
-				Type:        schema.TypeList,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+			"allowed_principals": schema.ListAttribute{
 				Optional:    true,
+				ElementType: types.StringType,
 				Description: "List of allowed prinicpals used by AWS",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(AWS_ARN_VALIDATE_RE,
+						"must be an AWS IAM ARN, e.g. arn:aws:iam::<account-id>:root")),
+				},
 			},
-			"approved_subscriptions": {
-				Type:        schema.TypeList,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+			"approved_subscriptions": schema.ListAttribute{
 				Optional:    true,
+				ElementType: types.StringType,
 				Description: "List of approved subscriptions used by Azure",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(AZURE_SUBS_VALIDATE_RE,
+						"must be an Azure subscription identifier")),
+				},
 			},
-			"allowed_projects": {
-				Type:        schema.TypeList,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+			"allowed_projects": schema.ListAttribute{
 				Optional:    true,
+				ElementType: types.StringType,
 				Description: "List of allowed projects used by GCP",
-			},
-			"active_zones": {
-				Type: schema.TypeList,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(GCP_PROJECT_ID_VALIDATE_RE,
+						"must be a GCP project identifier")),
 				},
+			},
+			"active_zones": schema.ListAttribute{
 				Computed:    true,
+				ElementType: types.StringType,
 				Description: "Covering availability zones used when creating an endpoint from other VPC. [AWS]",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"status": {
-				Type:        schema.TypeString,
+			"status": schema.StringAttribute{
 				Computed:    true,
 				Description: "Status of the Private Service Connect [enabled, pending, disabled]",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"service_name": {
-				Type:        schema.TypeString,
+			"service_name": schema.StringAttribute{
 				Computed:    true,
 				Description: "Service name (alias for Azure) of the PrivateLink.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"sleep": {
-				Type:        schema.TypeInt,
+			"server_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the server having the PrivateLink enabled. [Azure]",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sleep": schema.Int64Attribute{
 				Optional:    true,
-				Default:     10,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10),
 				Description: "Configurable sleep in seconds between retries when enable PrivateLink",
 			},
-			"timeout": {
-				Type:        schema.TypeInt,
+			"timeout": schema.Int64Attribute{
 				Optional:    true,
-				Default:     3600,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3600),
 				Description: "Configurable timeout in seconds when enable PrivateLink",
 			},
 		},
-		CustomizeDiff: customdiff.All(
-			customdiff.ValidateValue("allowed_principals", func(ctx context.Context,
-				value, meta any) error {
-
-				for _, v := range value.([]any) {
-					if AWS_ARN_VALIDATE_RE.MatchString(v.(string)) {
-						continue
-					} else {
-						return fmt.Errorf("invalid format for 'allowed_principals': %s", v.(string))
-					}
-				}
-				return nil
-			}),
-			customdiff.ValidateValue("approved_subscriptions", func(ctx context.Context, value,
-				meta any) error {
-
-				for _, v := range value.([]any) {
-					if AZURE_SUBS_VALIDATE_RE.MatchString(v.(string)) {
-						continue
-					} else {
-						return fmt.Errorf("invalid format for 'approved_subscriptions': %s", v.(string))
-					}
-				}
-				return nil
-			}),
-			customdiff.ValidateValue("allowed_projects", func(ctx context.Context, value,
-				meta any) error {
-
-				for _, v := range value.([]any) {
-					if GCP_PROJECT_ID_VALIDATE_RE.MatchString(v.(string)) {
-						continue
-					} else {
-						return fmt.Errorf("invalid format for 'allowed_projets': %s", v.(string))
-					}
-				}
-				return nil
-			}),
-		),
 	}
 }
 
-func resourceVpcConnectCreate(ctx context.Context, d *schema.ResourceData,
-	meta any) diag.Diagnostics {
+func (r *vpcConnectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
 
-	var (
-		api        = meta.(*api.API)
-		instanceID = d.Get("instance_id").(int)
-		region     = d.Get("region").(string)
-		sleep      = d.Get("sleep").(int)
-		timeout    = d.Get("timeout").(int)
-		params     = make(map[string][]any)
-	)
+func (r *vpcConnectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vpcConnectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	switch getPlatform(region) {
-	case "amazon":
-		params["allowed_principals"] = d.Get("allowed_principals").([]any)
-	case "azure":
-		params["approved_subscriptions"] = d.Get("approved_subscriptions").([]any)
-	case "google":
-		params["allowed_projects"] = d.Get("allowed_projects").([]any)
-	default:
-		return diag.Errorf("invalid region")
+	// Region not known yet, validated in ModifyPlan once read from the instance.
+	if config.Region.IsNull() || config.Region.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(validateVpcConnectAllowlists(config)...)
+}
+
+func (r *vpcConnectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vpcConnectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := api.EnableVpcConnect(ctx, instanceID, params, sleep, timeout)
+	var configRegion types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &configRegion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configured region already validated in ValidateConfig
+	if !configRegion.IsNull() {
+		return
+	}
+
+	// Region not configured, derive it from the instance unless kept from the state.
+	if plan.Region.IsUnknown() || plan.Region.IsNull() {
+		if plan.InstanceID.IsUnknown() {
+			return
+		}
+		region, err := r.readInstanceRegion(ctx, plan.InstanceID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read instance region", err.Error())
+			return
+		}
+		plan.Region = types.StringValue(region)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("region"), region)...)
+	}
+	resp.Diagnostics.Append(validateVpcConnectAllowlists(plan)...)
+}
+
+func (r *vpcConnectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Invalid import ID format",
+			fmt.Sprintf("Expected the instance identifier, got: %s", req.ID))
+		return
 	}
 
-	d.SetId(fmt.Sprintf("%d", instanceID))
-	return resourceVpcConnectRead(ctx, d, meta)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sleep"), int64(10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(3600))...)
+}
+
+func (r *vpcConnectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: r.movePrivateLinkState},
+	}
 }
 
-func resourceVpcConnectRead(ctx context.Context, d *schema.ResourceData,
-	meta any) diag.Diagnostics {
+// movePrivateLinkState: moves state from the cloudamqp_privatelink_aws and
+// cloudamqp_privatelink_azure resources.
+func (r *vpcConnectResource) movePrivateLinkState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !strings.HasSuffix(req.SourceProviderAddress, "/cloudamqp") {
+		return
+	}
+	if req.SourceTypeName != "cloudamqp_privatelink_aws" && req.SourceTypeName != "cloudamqp_privatelink_azure" {
+		return
+	}
+	if req.SourceRawState == nil {
+		resp.Diagnostics.AddError("Unable to move resource state", "Source resource state is missing")
+		return
+	}
+
+	var source struct {
+		InstanceID            int64    `json:"instance_id"`
+		Status                string   `json:"status"`
+		ServiceName           string   `json:"service_name"`
+		ServerName            *string  `json:"server_name"`
+		AllowedPrincipals     []string `json:"allowed_principals"`
+		ApprovedSubscriptions []string `json:"approved_subscriptions"`
+		ActiveZones           []string `json:"active_zones"`
+		Sleep                 int64    `json:"sleep"`
+		Timeout               int64    `json:"timeout"`
+	}
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError("Unable to move resource state",
+			fmt.Sprintf("Could not parse %s state: %s", req.SourceTypeName, err))
+		return
+	}
 
+	// The provider is not configured when moving state, region is read from the instance during plan.
 	var (
-		api           = meta.(*api.API)
-		instanceID, _ = strconv.Atoi(d.Id()) // Uses d.Id() to allow import
+		diags  diag.Diagnostics
+		target = vpcConnectResourceModel{
+			ID:                    types.StringValue(strconv.FormatInt(source.InstanceID, 10)),
+			InstanceID:            types.Int64Value(source.InstanceID),
+			Region:                types.StringNull(),
+			AllowedPrincipals:     types.ListNull(types.StringType),
+			ApprovedSubscriptions: types.ListNull(types.StringType),
+			AllowedProjects:       types.ListNull(types.StringType),
+			Status:                types.StringValue(source.Status),
+			ServiceName:           types.StringValue(source.ServiceName),
+			ServerName:            types.StringPointerValue(source.ServerName),
+			Sleep:                 types.Int64Value(source.Sleep),
+			Timeout:               types.Int64Value(source.Timeout),
+		}
 	)
 
-	// Set arguments during import
-	if d.Get("instance_id").(int) == 0 {
-		d.Set("instance_id", instanceID)
+	if req.SourceTypeName == "cloudamqp_privatelink_aws" {
+		target.AllowedPrincipals, diags = types.ListValueFrom(ctx, types.StringType, source.AllowedPrincipals)
+	} else {
+		target.ApprovedSubscriptions, diags = types.ListValueFrom(ctx, types.StringType, source.ApprovedSubscriptions)
 	}
-	if d.Get("sleep").(int) == 0 && d.Get("timeout").(int) == 0 {
-		d.Set("sleep", 10)
-		d.Set("timeout", 3600)
+	resp.Diagnostics.Append(diags...)
+	if source.ActiveZones == nil {
+		source.ActiveZones = []string{}
+	}
+	target.ActiveZones, diags = types.ListValueFrom(ctx, types.StringType, source.ActiveZones)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	data, err := api.ReadVpcConnect(ctx, instanceID)
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+}
+
+func (r *vpcConnectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcConnectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := plan.InstanceID.ValueInt64()
+	if plan.Region.IsUnknown() {
+		region, err := r.readInstanceRegion(ctx, instanceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read instance region", err.Error())
+			return
+		}
+		plan.Region = types.StringValue(region)
+		resp.Diagnostics.Append(validateVpcConnectAllowlists(plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	params, diags := vpcConnectRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.EnableVpcConnect(ctx, instanceID, params, int(plan.Sleep.ValueInt64()),
+		int(plan.Timeout.ValueInt64()))
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Failed to enable VPC Connect",
+			fmt.Sprintf("Could not enable VPC Connect for instance %d: %s", instanceID, err),
+		)
+		return
+	}
+
+	data, err := r.client.ReadVpcConnect(ctx, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read VPC Connect",
+			fmt.Sprintf("Could not read VPC Connect for instance %d: %s", instanceID, err),
+		)
+		return
+	}
+	if data == nil {
+		resp.Diagnostics.AddError(
+			"VPC Connect not found",
+			fmt.Sprintf("VPC Connect for instance %d could not be found after being enabled", instanceID),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(instanceID, 10))
+	resp.Diagnostics.Append(populateVpcConnectModel(ctx, *data, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcConnectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcConnectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := state.InstanceID.ValueInt64()
+	data, err := r.client.ReadVpcConnect(ctx, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read VPC Connect",
+			fmt.Sprintf("Could not read VPC Connect for instance %d: %s", instanceID, err),
+		)
+		return
 	}
 
 	// Resource drift: instance or resource not found, trigger re-creation
 	if data == nil {
-		tflog.Info(ctx, fmt.Sprintf("vpc connect not found, resource will be recreated: %s", d.Id()))
-		d.SetId("")
-		return nil
-	}
-
-	d.Set("active_zones", []string{})
-	for k, v := range data {
-		if validateVpcConnectSchemaAttribute(k) {
-			if k == "alias" {
-				d.Set("service_name", v)
-			} else {
-				d.Set(k, v)
-			}
+		tflog.Info(ctx, fmt.Sprintf("vpc connect not found, resource will be recreated: %d", instanceID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(populateVpcConnectModel(ctx, *data, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vpcConnectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vpcConnectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := vpcConnectRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := plan.InstanceID.ValueInt64()
+	if err := r.client.UpdateVpcConnect(ctx, instanceID, params); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update VPC Connect",
+			fmt.Sprintf("Could not update VPC Connect for instance %d: %s", instanceID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcConnectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcConnectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := state.InstanceID.ValueInt64()
+	if err := r.client.DisableVpcConnect(ctx, instanceID); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to disable VPC Connect",
+			fmt.Sprintf("Could not disable VPC Connect for instance %d: %s", instanceID, err),
+		)
+	}
+}
+
+func (r *vpcConnectResource) readInstanceRegion(ctx context.Context, instanceID int64) (string, error) {
+	data, err := r.client.ReadInstance(ctx, strconv.FormatInt(instanceID, 10))
+	if err != nil {
+		return "", err
+	}
+	if data == nil {
+		return "", fmt.Errorf("instance %d not found", instanceID)
+	}
+	region, ok := data["region"].(string)
+	if !ok || region == "" {
+		return "", fmt.Errorf("region missing for instance %d", instanceID)
+	}
+	return region, nil
+}
+
+// validateVpcConnectAllowlists: validates that only the allowlist for the platform of the region
+// is used.
+func validateVpcConnectAllowlists(config vpcConnectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	platform := getPlatform(config.Region.ValueString())
+	if platform == "" {
+		diags.AddAttributeError(path.Root("region"), "Invalid region",
+			fmt.Sprintf("Region %s is not supported by VPC Connect", config.Region.ValueString()))
+		return diags
+	}
+
+	allowlists := map[string]types.List{
+		"allowed_principals":     config.AllowedPrincipals,
+		"approved_subscriptions": config.ApprovedSubscriptions,
+		"allowed_projects":       config.AllowedProjects,
+	}
+	for name, list := range allowlists {
+		if name == vpcConnectAllowlists[platform] || list.IsNull() || list.IsUnknown() {
+			continue
+		}
+		if len(list.Elements()) > 0 {
+			diags.AddAttributeError(path.Root(name), "Invalid attribute for region",
+				fmt.Sprintf("%s can not be used for region %s, use %s instead", name,
+					config.Region.ValueString(), vpcConnectAllowlists[platform]))
 		}
 	}
-	return diag.Diagnostics{}
+	return diags
 }
 
-func resourceVpcConnectUpdate(ctx context.Context, d *schema.ResourceData,
-	meta any) diag.Diagnostics {
+// vpcConnectRequest: builds the request with the allowlist for the platform of the region.
+func vpcConnectRequest(ctx context.Context, plan vpcConnectResourceModel) (model.VpcConnectRequest,
+	diag.Diagnostics) {
 
 	var (
-		api        = meta.(*api.API)
-		instanceID = d.Get("instance_id").(int)
-		region     = d.Get("region").(string)
-		params     = make(map[string][]any)
+		params    model.VpcConnectRequest
+		allowlist = make([]string, 0)
+		diags     diag.Diagnostics
 	)
 
-	switch getPlatform(region) {
+	switch getPlatform(plan.Region.ValueString()) {
 	case "amazon":
-		params["allowed_principals"] = d.Get("allowed_principals").([]any)
+		diags = plan.AllowedPrincipals.ElementsAs(ctx, &allowlist, false)
+		params.AllowedPrincipals = &allowlist
 	case "azure":
-		params["approved_subscriptions"] = d.Get("approved_subscriptions").([]any)
+		diags = plan.ApprovedSubscriptions.ElementsAs(ctx, &allowlist, false)
+		params.ApprovedSubscriptions = &allowlist
 	case "google":
-		params["allowed_projects"] = d.Get("allowed_projects").([]any)
+		diags = plan.AllowedProjects.ElementsAs(ctx, &allowlist, false)
+		params.AllowedProjects = &allowlist
 	default:
-		return diag.Errorf("invalid region")
-	}
-
-	err := api.UpdateVpcConnect(ctx, instanceID, params)
-	if err != nil {
-		return diag.FromErr(err)
+		diags.AddError("Invalid region", fmt.Sprintf("Region %s is not supported by VPC Connect",
+			plan.Region.ValueString()))
 	}
-	return diag.Diagnostics{}
+	return params, diags
 }
 
-func resourceVpcConnectDelete(ctx context.Context, d *schema.ResourceData,
-	meta any) diag.Diagnostics {
+// populateVpcConnectModel: sets computed attributes and the allowlist returned by the API, other
+// allowlists are kept as configured.
+func populateVpcConnectModel(ctx context.Context, data model.VpcConnectResponse,
+	state *vpcConnectResourceModel) diag.Diagnostics {
 
 	var (
-		api        = meta.(*api.API)
-		instanceID = d.Get("instance_id").(int)
+		diags diag.Diagnostics
+		d     diag.Diagnostics
 	)
 
-	err := api.DisableVpcConnect(ctx, instanceID)
-	if err != nil {
-		return diag.FromErr(err)
+	state.Status = types.StringValue(data.Status)
+	state.ServiceName = types.StringValue(data.ServiceName)
+	if data.Alias != "" {
+		state.ServiceName = types.StringValue(data.Alias)
 	}
-	return diag.Diagnostics{}
-}
+	state.ServerName = types.StringNull()
+	if data.ServerName != "" {
+		state.ServerName = types.StringValue(data.ServerName)
+	}
+	if data.ActiveZones == nil {
+		data.ActiveZones = []string{}
+	}
+	state.ActiveZones, d = types.ListValueFrom(ctx, types.StringType, data.ActiveZones)
+	diags.Append(d...)
 
-func validateVpcConnectSchemaAttribute(key string) bool {
-	switch key {
-	case "active_zones",
-		"alias",
-		"allowed_principals",
-		"allowed_projects",
-		"approved_subscriptions",
-		"service_name",
-		"status":
-		return true
-	}
-	return false
+	if data.AllowedPrincipals != nil {
+		state.AllowedPrincipals, d = types.ListValueFrom(ctx, types.StringType, *data.AllowedPrincipals)
+		diags.Append(d...)
+	}
+	if data.ApprovedSubscriptions != nil {
+		state.ApprovedSubscriptions, d = types.ListValueFrom(ctx, types.StringType, *data.ApprovedSubscriptions)
+		diags.Append(d...)
+	}
+	if data.AllowedProjects != nil {
+		state.AllowedProjects, d = types.ListValueFrom(ctx, types.StringType, *data.AllowedProjects)
+		diags.Append(d...)
+	}
+	return diags
 }
 
 func getPlatform(region string) string {
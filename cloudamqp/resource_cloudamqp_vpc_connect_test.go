@@ -1,7 +1,11 @@
 package cloudamqp
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
 	"testing"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
@@ -168,6 +172,109 @@ func TestAccVpcConnect_GCP_Basic(t *testing.T) {
 	})
 }
 
+// vpcConnectBackend: local backend serving an AWS instance where PrivateLink and VPC Connect share
+// the same allowlist.
+type vpcConnectBackend struct {
+	mu                sync.Mutex
+	enabled           bool
+	allowedPrincipals []string
+}
+
+func (b *vpcConnectBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/instances/1234":
+		json.NewEncoder(w).Encode(map[string]any{
+			"id":     1234,
+			"region": "amazon-web-services::us-east-1",
+			"vpc":    map[string]any{"id": 1},
+		})
+	case r.URL.Path == "/api/instances/1234/privatelink" || r.URL.Path == "/api/instances/1234/vpc-connect":
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			var params struct {
+				AllowedPrincipals []string `json:"allowed_principals"`
+			}
+			json.NewDecoder(r.Body).Decode(&params)
+			b.enabled = true
+			b.allowedPrincipals = params.AllowedPrincipals
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			if !b.enabled {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"status":             "enabled",
+				"service_name":       "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789abcdef0",
+				"allowed_principals": b.allowedPrincipals,
+				"active_zones":       []string{"use1-az6"},
+			})
+		case http.MethodDelete:
+			b.enabled = false
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// TestAccVpcConnect_MovedFromPrivateLinkAws: Move PrivateLink AWS to VPC Connect with region read
+// from the instance, then validate allowlists for the region.
+func TestAccVpcConnect_MovedFromPrivateLinkAws(t *testing.T) {
+	t.Parallel()
+
+	vpcConnectResourceName := "cloudamqp_vpc_connect.vpc_connect"
+
+	cloudamqpLocalResourceTest(t, &vpcConnectBackend{}, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "cloudamqp_privatelink_aws" "privatelink" {
+						instance_id        = 1234
+						allowed_principals = ["arn:aws:iam::123456789012:root"]
+					}
+				`,
+			},
+			{
+				Config: `
+					moved {
+						from = cloudamqp_privatelink_aws.privatelink
+						to   = cloudamqp_vpc_connect.vpc_connect
+					}
+
+					resource "cloudamqp_vpc_connect" "vpc_connect" {
+						instance_id        = 1234
+						allowed_principals = ["arn:aws:iam::123456789012:root"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vpcConnectResourceName, "id", "1234"),
+					resource.TestCheckResourceAttr(vpcConnectResourceName, "region", "amazon-web-services::us-east-1"),
+					resource.TestCheckResourceAttr(vpcConnectResourceName, "status", "enabled"),
+					resource.TestCheckResourceAttr(vpcConnectResourceName, "allowed_principals.#", "1"),
+					resource.TestCheckResourceAttr(vpcConnectResourceName, "active_zones.0", "use1-az6"),
+					resource.TestCheckResourceAttr(vpcConnectResourceName, "timeout", "3600"),
+				),
+			},
+			{
+				Config: `
+					resource "cloudamqp_vpc_connect" "vpc_connect" {
+						instance_id            = 1234
+						allowed_principals     = ["arn:aws:iam::123456789012:root"]
+						approved_subscriptions = ["56fab608-c846-4770-a493-e77f52c1ce41"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`approved_subscriptions can not be used for region`),
+			},
+		},
+	})
+}
+
 func testAccImportStateIdFunc(vpcConnectResourceName string) resource.ImportStateIdFunc {
 	return func(state *terraform.State) (string, error) {
 		rs, ok := state.RootModule().Resources[vpcConnectResourceName]
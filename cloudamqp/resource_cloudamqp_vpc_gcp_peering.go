@@ -2,264 +2,314 @@ package cloudamqp
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
-	"strconv"
 	"strings"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func resourceVpcGcpPeering() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceCreateVpcGcpPeering,
-		ReadContext:   resourceReadVpcGcpPeering,
-		UpdateContext: resourceUpdateVpcGcpPeering,
-		DeleteContext: resourceDeleteVpcGcpPeering,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"instance_id": {
-				Type:        schema.TypeInt,
+var (
+	_ resource.Resource                     = &vpcGcpPeeringResource{}
+	_ resource.ResourceWithConfigure        = &vpcGcpPeeringResource{}
+	_ resource.ResourceWithConfigValidators = &vpcGcpPeeringResource{}
+	_ resource.ResourceWithImportState      = &vpcGcpPeeringResource{}
+)
+
+type vpcGcpPeeringResource struct {
+	client *api.API
+}
+
+func NewVpcGcpPeeringResource() resource.Resource {
+	return &vpcGcpPeeringResource{}
+}
+
+type vpcGcpPeeringResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	InstanceID          types.Int64  `tfsdk:"instance_id"`
+	VpcID               types.String `tfsdk:"vpc_id"`
+	PeerNetworkUri      types.String `tfsdk:"peer_network_uri"`
+	WaitOnPeeringStatus types.Bool   `tfsdk:"wait_on_peering_status"`
+	Status              types.String `tfsdk:"status"`
+	StatusMessage       types.String `tfsdk:"status_message"`
+	State               types.String `tfsdk:"state"`
+	StateDetails        types.String `tfsdk:"state_details"`
+	AutoCreateRoutes    types.Bool   `tfsdk:"auto_create_routes"`
+	Sleep               types.Int64  `tfsdk:"sleep"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *vpcGcpPeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_vpc_gcp_peering"
+}
+
+func (r *vpcGcpPeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Request a VPC peering to a GCP network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The VPC peering name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Instance identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
-			"vpc_id": {
-				Type:        schema.TypeString,
+			"vpc_id": schema.StringAttribute{
 				Optional:    true,
 				Description: "VPC instance identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"peer_network_uri": {
-				Type:        schema.TypeString,
+			"peer_network_uri": schema.StringAttribute{
 				Required:    true,
 				Description: "VPC network uri",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"wait_on_peering_status": {
-				Type:        schema.TypeBool,
+			"wait_on_peering_status": schema.BoolAttribute{
 				Optional:    true,
-				Default:     false,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
 				Description: "Wait until peering status change to 'connected'",
 			},
-			"state": {
-				Type:        schema.TypeString,
+			"status": schema.StringAttribute{
 				Computed:    true,
-				Description: "VPC peering state",
+				Description: "VPC peering status",
 			},
-			"state_details": {
-				Type:        schema.TypeString,
+			"status_message": schema.StringAttribute{
 				Computed:    true,
-				Description: "VPC peering state details",
+				Description: "VPC peering status message",
+			},
+			"state": schema.StringAttribute{
+				Computed:           true,
+				Description:        "VPC peering state",
+				DeprecationMessage: "Use 'status' instead. This attribute will be removed in a future version.",
+			},
+			"state_details": schema.StringAttribute{
+				Computed:           true,
+				Description:        "VPC peering state details",
+				DeprecationMessage: "Use 'status_message' instead. This attribute will be removed in a future version.",
 			},
-			"auto_create_routes": {
-				Type:        schema.TypeBool,
+			"auto_create_routes": schema.BoolAttribute{
 				Computed:    true,
 				Description: "VPC peering auto created routes",
 			},
-			"sleep": {
-				Type:        schema.TypeInt,
+			"sleep": schema.Int64Attribute{
 				Optional:    true,
-				Default:     10,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10),
 				Description: "Configurable sleep in seconds between retries when requesting or reading peering",
 			},
-			"timeout": {
-				Type:        schema.TypeInt,
+			"timeout": schema.Int64Attribute{
 				Optional:    true,
-				Default:     1800,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1800),
 				Description: "Configurable timeout time (seconds) before retries times out",
 			},
 		},
 	}
 }
 
-func resourceCreateVpcGcpPeering(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var (
-		api          = meta.(*api.API)
-		keys         = []string{"peer_network_uri"}
-		params       = make(map[string]any)
-		instanceID   = d.Get("instance_id").(int)
-		vpcID        = d.Get("vpc_id").(string)
-		waitOnStatus = d.Get("wait_on_peering_status").(bool)
-		data         map[string]any
-		err          = errors.New("")
-		sleep        = d.Get("sleep").(int)
-		timeout      = d.Get("timeout").(int)
-	)
-
-	for _, k := range keys {
-		if v := d.Get(k); v != nil && v != "" {
-			params[k] = v
-		}
+func (r *vpcGcpPeeringResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("instance_id"),
+			path.MatchRoot("vpc_id"),
+		),
 	}
+}
 
-	if instanceID == 0 && vpcID == "" {
-		return diag.Errorf("you need to specify either instance_id or vpc_id")
-	} else if instanceID != 0 {
-		data, err = api.RequestVpcGcpPeering(ctx, instanceID, params, waitOnStatus, sleep, timeout)
-	} else if vpcID != "" {
-		data, err = api.RequestVpcGcpPeeringWithVpcId(ctx, vpcID, params, waitOnStatus, sleep, timeout)
+func (r *vpcGcpPeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
+	r.client = client
+}
 
+func (r *vpcGcpPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, vpcID, peeringID, err := parseVpcPeeringImportID(req.ID)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Invalid import ID format", err.Error())
+		return
 	}
 
-	for k, v := range data {
-		if k == "peering" {
-			d.SetId(v.(string))
+	// Previous import format used the peer network uri, look up the peering name.
+	if strings.HasPrefix(peeringID, "https://") {
+		peerings, err := r.client.ListVpcGcpPeerings(ctx, instanceID, vpcID, 10, 1800)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list VPC peerings", err.Error())
+			return
+		}
+		networkUri := peeringID
+		peeringID = ""
+		for _, peering := range peerings {
+			if peering.Network == networkUri {
+				peeringID = peering.Name
+				break
+			}
+		}
+		if peeringID == "" {
+			resp.Diagnostics.AddError("VPC peering not found",
+				fmt.Sprintf("No VPC peering found for peer network uri %s", networkUri))
+			return
 		}
 	}
 
-	return resourceReadVpcGcpPeering(ctx, d, meta)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), peeringID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_on_peering_status"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sleep"), int64(10))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(1800))...)
+	if vpcID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_id"), vpcID)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	}
 }
 
-func resourceReadVpcGcpPeering(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func (r *vpcGcpPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcGcpPeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var (
-		api        = meta.(*api.API)
-		data       map[string]any
-		err        = errors.New("")
-		sleep      = d.Get("sleep").(int)
-		timeout    = d.Get("timeout").(int)
-		instanceID = d.Get("instance_id").(int)
-		vpcID      = d.Get("vpc_id").(string)
+		instanceID = plan.InstanceID.ValueInt64()
+		vpcID      = plan.VpcID.ValueString()
+		sleep      = int(plan.Sleep.ValueInt64())
+		timeout    = int(plan.Timeout.ValueInt64())
+		params     = model.GcpPeeringRequest{PeerNetworkUri: plan.PeerNetworkUri.ValueString()}
 	)
 
-	// Check to determine if the resource should be imported.
-	if strings.Contains(d.Id(), ",") {
-		tflog.Info(ctx, fmt.Sprintf("import of resource with identifiers: %s", d.Id()))
-		return resourceImportVpcGcpPeering(ctx, d, meta)
-	}
-
-	if instanceID == 0 && vpcID == "" {
-		return diag.Errorf("you need to specify either instance_id or vpc_id")
-	} else if instanceID != 0 {
-		data, err = api.ReadVpcGcpPeering(ctx, instanceID, sleep, timeout)
-	} else if vpcID != "" {
-		data, err = api.ReadVpcGcpPeeringWithVpcId(ctx, vpcID, sleep, timeout)
+	peeringID, err := r.client.RequestVpcGcpPeering(ctx, instanceID, vpcID, params,
+		plan.WaitOnPeeringStatus.ValueBool(), sleep, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to request VPC peering",
+			fmt.Sprintf("Could not request VPC peering to %s: %s", params.PeerNetworkUri, err),
+		)
+		return
 	}
 
+	data, err := r.client.ReadVpcGcpPeering(ctx, instanceID, vpcID, peeringID, sleep, timeout)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Failed to read VPC peering",
+			fmt.Sprintf("Could not read VPC peering %s: %s", peeringID, err),
+		)
+		return
 	}
-
-	rows := data["rows"].([]any)
-	if len(rows) > 0 {
-		for _, row := range rows {
-			tempRow := row.(map[string]any)
-			if tempRow["name"] != d.Id() {
-				continue
-			}
-			for k, v := range tempRow {
-				switch k {
-				case "autoCreateRoutes":
-					d.Set("auto_create_routes", v.(bool))
-				case "network":
-					d.Set("peer_network_uri", v.(string))
-				case "state":
-					d.Set("state", v.(string))
-				case "stateDetails":
-					d.Set("state_details", v.(string))
-				}
-			}
-		}
-	} else {
-		return diag.Errorf("no peering data available")
+	if data == nil {
+		resp.Diagnostics.AddError(
+			"VPC peering not found",
+			fmt.Sprintf("VPC peering %s could not be found after being requested", peeringID),
+		)
+		return
 	}
 
-	return diag.Diagnostics{}
+	r.populateResourceModel(*data, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceImportVpcGcpPeering(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var (
-		api     = meta.(*api.API)
-		data    map[string]any
-		err     = errors.New("")
-		sleep   = 10
-		timeout = 1800
-	)
-
-	// Set default values to arguments
-	d.Set("sleep", 10)
-	d.Set("timeout", 1800)
-	d.Set("wait_on_peering_status", false)
-
-	log.Printf("[DEBUG] cloudamqp::resource::vpc_gcp_peering#import id: %v", d.Id())
-	importValues := strings.Split(d.Id(), ",")
-	if len(importValues) < 3 {
-		return diag.Errorf("wrong number of import argument, need all three <type>,<id>,<peer_network_uri>")
-	}
-	if importValues[0] == "instance" {
-		instanceID, _ := strconv.Atoi(importValues[1])
-		d.Set("instance_id", instanceID)
-		data, err = api.ReadVpcGcpPeering(ctx, instanceID, sleep, timeout)
-	} else if importValues[0] == "vpc" {
-		vpcID := importValues[1]
-		d.Set("vpc_id", vpcID)
-		data, err = api.ReadVpcGcpPeeringWithVpcId(ctx, vpcID, sleep, timeout)
+func (r *vpcGcpPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcGcpPeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	// Zero values stored by the SDKv2 implementation are treated as unset.
+	normalizePeeringTarget(&state.InstanceID, &state.VpcID)
+
+	peeringID := state.ID.ValueString()
+	data, err := r.client.ReadVpcGcpPeering(ctx, state.InstanceID.ValueInt64(), state.VpcID.ValueString(),
+		peeringID, int(state.Sleep.ValueInt64()), int(state.Timeout.ValueInt64()))
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(
+			"Failed to read VPC peering",
+			fmt.Sprintf("Could not read VPC peering %s: %s", peeringID, err),
+		)
+		return
 	}
 
-	rows := data["rows"].([]any)
-	if len(rows) > 0 {
-		for _, row := range rows {
-			tempRow := row.(map[string]any)
-			if tempRow["network"] != importValues[2] {
-				continue
-			}
-			for k, v := range tempRow {
-				switch k {
-				case "autoCreateRoutes":
-					d.Set("auto_create_routes", v.(bool))
-				case "name":
-					d.SetId(v.(string))
-				case "network":
-					d.Set("peer_network_uri", v.(string))
-				case "state":
-					d.Set("state", v.(string))
-				case "stateDetails":
-					d.Set("state_details", v.(string))
-				}
-			}
-		}
-	} else {
-		return diag.Errorf("no peering data available")
+	// Resource drift: peering not found, trigger re-creation
+	if data == nil {
+		tflog.Info(ctx, fmt.Sprintf("VPC peering not found, resource will be recreated: %s", peeringID))
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	return diag.Diagnostics{}
+	r.populateResourceModel(*data, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceUpdateVpcGcpPeering(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return resourceReadVpcGcpPeering(ctx, d, meta)
+func (r *vpcGcpPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcGcpPeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only wait_on_peering_status, sleep and timeout can be updated, keep the peering status from the state.
+	plan.Status = state.Status
+	plan.StatusMessage = state.StatusMessage
+	plan.State = state.State
+	plan.StateDetails = state.StateDetails
+	plan.AutoCreateRoutes = state.AutoCreateRoutes
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceDeleteVpcGcpPeering(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var (
-		api        = meta.(*api.API)
-		instanceID = d.Get("instance_id").(int)
-		vpcID      = d.Get("vpc_id").(string)
-		sleep      = d.Get("sleep").(int)
-		timeout    = d.Get("timeout").(int)
-	)
+func (r *vpcGcpPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcGcpPeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if instanceID == 0 && vpcID == "" {
-		return diag.Errorf("you need to specify either instance_id or vpc_id")
-	} else if instanceID != 0 {
-		if err := api.RemoveVpcGcpPeering(ctx, instanceID, d.Id(), sleep, timeout); err != nil {
-			return diag.FromErr(err)
-		}
-	} else if vpcID != "" {
-		if err := api.RemoveVpcGcpPeeringWithVpcId(ctx, vpcID, d.Id(), sleep, timeout); err != nil {
-			return diag.FromErr(err)
-		}
-	} else {
-		return diag.Errorf("failed to remove VPC peering")
+	peeringID := state.ID.ValueString()
+	err := r.client.RemoveVpcGcpPeering(ctx, state.InstanceID.ValueInt64(), state.VpcID.ValueString(),
+		peeringID, int(state.Sleep.ValueInt64()), int(state.Timeout.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove VPC peering",
+			fmt.Sprintf("Could not remove VPC peering %s: %s", peeringID, err),
+		)
 	}
-	return diag.Diagnostics{}
+}
+
+func (r *vpcGcpPeeringResource) populateResourceModel(data model.GcpPeeringResponse, state *vpcGcpPeeringResourceModel) {
+	populatePeeringStatus(data.Peering(), &state.ID, &state.Status, &state.StatusMessage)
+	state.PeerNetworkUri = types.StringValue(data.Network)
+	state.State = types.StringValue(data.State)
+	state.StateDetails = types.StringValue(data.StateDetails)
+	state.AutoCreateRoutes = types.BoolValue(data.AutoCreateRoutes)
 }
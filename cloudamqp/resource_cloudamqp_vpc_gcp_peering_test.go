@@ -45,11 +45,12 @@ func TestAccVpcGcpPeering_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(vpcGcpPeeringResourceName, "auto_create_routes", "true"),
 					resource.TestCheckResourceAttr(vpcGcpPeeringResourceName, "wait_on_peering_status", "true"),
 					resource.TestCheckResourceAttr(vpcGcpPeeringResourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttr(vpcGcpPeeringResourceName, "status", "ACTIVE"),
 				),
 			},
 			{
 				ResourceName:            vpcGcpPeeringResourceName,
-				ImportStateIdFunc:       testAccImportVpcPeeringStateIdFunc("vpc", vpcResourceName, peerNetworkUri),
+				ImportStateIdFunc:       testAccImportVpcPeeringStateIdFunc(vpcResourceName, vpcGcpPeeringResourceName),
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"wait_on_peering_status"},
@@ -58,16 +59,21 @@ func TestAccVpcGcpPeering_Basic(t *testing.T) {
 	})
 }
 
-func testAccImportVpcPeeringStateIdFunc(importType, resourceName, peerNetworkUri string) resource.ImportStateIdFunc {
+// testAccImportVpcPeeringStateIdFunc returns an ImportStateIdFunc that combines the VPC identifier and
+// the peering identifier, {vpc_id},{peering_id}.
+func testAccImportVpcPeeringStateIdFunc(vpcResourceName, peeringResourceName string) resource.ImportStateIdFunc {
 	return func(state *terraform.State) (string, error) {
-		rs, ok := state.RootModule().Resources[resourceName]
+		vpc, ok := state.RootModule().Resources[vpcResourceName]
 		if !ok {
-			return "", fmt.Errorf("Resource %s not found", resourceName)
+			return "", fmt.Errorf("Resource %s not found", vpcResourceName)
 		}
-		if rs.Primary.ID == "" {
+		peering, ok := state.RootModule().Resources[peeringResourceName]
+		if !ok {
+			return "", fmt.Errorf("Resource %s not found", peeringResourceName)
+		}
+		if vpc.Primary.ID == "" || peering.Primary.ID == "" {
 			return "", fmt.Errorf("No resource id set")
 		}
-		resourceID := rs.Primary.ID
-		return fmt.Sprintf("%s,%s,%s", importType, resourceID, peerNetworkUri), nil
+		return fmt.Sprintf("%s,%s", vpc.Primary.ID, peering.Primary.ID), nil
 	}
 }
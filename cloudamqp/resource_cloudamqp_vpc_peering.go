@@ -2,201 +2,300 @@ package cloudamqp
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudamqp/terraform-provider-cloudamqp/api"
+	model "github.com/cloudamqp/terraform-provider-cloudamqp/api/models/network"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func resourceVpcPeering() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceVpcPeeringAccept,
-		ReadContext:   resourceVpcPeeringRead,
-		UpdateContext: resourceVpcPeeringAccept,
-		DeleteContext: resourceVpcPeeringDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"instance_id": {
-				Type:        schema.TypeInt,
+var (
+	_ resource.Resource                     = &vpcPeeringResource{}
+	_ resource.ResourceWithConfigure        = &vpcPeeringResource{}
+	_ resource.ResourceWithConfigValidators = &vpcPeeringResource{}
+	_ resource.ResourceWithImportState      = &vpcPeeringResource{}
+)
+
+type vpcPeeringResource struct {
+	client *api.API
+}
+
+func NewVpcPeeringResource() resource.Resource {
+	return &vpcPeeringResource{}
+}
+
+type vpcPeeringResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	InstanceID    types.Int64  `tfsdk:"instance_id"`
+	VpcID         types.String `tfsdk:"vpc_id"`
+	PeeringID     types.String `tfsdk:"peering_id"`
+	Status        types.String `tfsdk:"status"`
+	StatusMessage types.String `tfsdk:"status_message"`
+	Sleep         types.Int64  `tfsdk:"sleep"`
+	Timeout       types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *vpcPeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "cloudamqp_vpc_peering"
+}
+
+func (r *vpcPeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Accept a VPC peering request from an AWS requester.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The VPC peering connection identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Instance identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
-			"vpc_id": {
-				Type:        schema.TypeString,
+			"vpc_id": schema.StringAttribute{
 				Optional:    true,
 				Description: "VPC instance identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"peering_id": {
-				Type:        schema.TypeString,
+			"peering_id": schema.StringAttribute{
 				Required:    true,
 				Description: "VPC peering identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"status": {
-				Type:        schema.TypeString,
+			"status": schema.StringAttribute{
 				Computed:    true,
 				Description: "VPC peering status",
 			},
-			"sleep": {
-				Type:        schema.TypeInt,
+			"status_message": schema.StringAttribute{
+				Computed:    true,
+				Description: "VPC peering status message",
+			},
+			"sleep": schema.Int64Attribute{
 				Optional:    true,
-				Default:     60,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60),
 				Description: "Configurable sleep time in seconds between retries for accepting or removing peering",
 			},
-			"timeout": {
-				Type:        schema.TypeInt,
+			"timeout": schema.Int64Attribute{
 				Optional:    true,
-				Default:     3600,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3600),
 				Description: "Configurable timeout time in seconds for accepting or removing peering",
 			},
 		},
 	}
 }
 
-func resourceVpcPeeringAccept(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var (
-		api        = meta.(*api.API)
-		err        = errors.New("")
-		instanceID = d.Get("instance_id").(int)
-		vpcID      = d.Get("vpc_id").(string)
-		peeringID  = d.Get("peering_id").(string)
-		sleep      = d.Get("sleep").(int)
-		timeout    = d.Get("timeout").(int)
-	)
+func (r *vpcPeeringResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("instance_id"),
+			path.MatchRoot("vpc_id"),
+		),
+	}
+}
 
-	if instanceID == 0 && vpcID == "" {
-		return diag.Errorf("you need to specify either instance_id or vpc_id")
-	} else if instanceID != 0 {
-		_, err = api.AcceptVpcPeering(ctx, instanceID, peeringID, sleep, timeout)
-	} else if vpcID != "" {
-		_, err = api.AcceptVpcPeeringWithVpcId(ctx, vpcID, peeringID, sleep, timeout)
+func (r *vpcPeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
+	client, ok := req.ProviderData.(*api.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected *api.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
 
+func (r *vpcPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instanceID, vpcID, peeringID, err := parseVpcPeeringImportID(req.ID)
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError("Invalid import ID format", err.Error())
+		return
 	}
 
-	return resourceVpcPeeringRead(ctx, d, meta)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), peeringID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("peering_id"), peeringID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sleep"), int64(60))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), int64(3600))...)
+	if vpcID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_id"), vpcID)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceID)...)
+	}
 }
 
-func resourceVpcPeeringRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func (r *vpcPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcPeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var (
-		api        = meta.(*api.API)
-		instanceID = d.Get("instance_id").(int)
-		vpcID      = d.Get("vpc_id").(string)
-		peeringID  = d.Get("peering_id").(string)
-		data       map[string]any
-		err        = errors.New("")
+		instanceID = plan.InstanceID.ValueInt64()
+		vpcID      = plan.VpcID.ValueString()
+		peeringID  = plan.PeeringID.ValueString()
+		sleep      = int(plan.Sleep.ValueInt64())
+		timeout    = int(plan.Timeout.ValueInt64())
 	)
 
-	// Check to determine if the resource should be imported.
-	if strings.Contains(d.Id(), ",") {
-		tflog.Info(ctx, fmt.Sprintf("import of resource with identifiers: %s", d.Id()))
-		if diag := resourceVpcPeeringImport(ctx, d, meta); diag != nil {
-			return diag
-		}
-	}
-
-	if instanceID == 0 && vpcID == "" {
-		return diag.Errorf("you need to specify either instance_id or vpc_id")
-	} else if instanceID != 0 {
-		data, err = api.ReadVpcPeeringRequest(ctx, instanceID, peeringID)
-	} else if d.Get("vpc_id") != nil {
-		data, err = api.ReadVpcPeeringRequestWithVpcId(ctx, vpcID, peeringID)
+	if err := r.client.AcceptVpcPeering(ctx, instanceID, vpcID, peeringID, sleep, timeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to accept VPC peering",
+			fmt.Sprintf("Could not accept VPC peering %s: %s", peeringID, err),
+		)
+		return
 	}
 
+	data, err := r.client.ReadVpcPeeringRequest(ctx, instanceID, vpcID, peeringID)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	for k, v := range data {
-		switch k {
-		case "vpc_peering_connection_id":
-			d.SetId(v.(string))
-		case "status":
-			status := v.(map[string]any)
-			if err = d.Set(k, status["code"]); err != nil {
-				return diag.Errorf("error setting status for resource %s: %s", d.Id(), err)
-			}
-		}
+		resp.Diagnostics.AddError(
+			"Failed to read VPC peering",
+			fmt.Sprintf("Could not read VPC peering %s: %s", peeringID, err),
+		)
+		return
+	}
+	if data == nil {
+		resp.Diagnostics.AddError(
+			"VPC peering not found",
+			fmt.Sprintf("VPC peering %s could not be found after being accepted", peeringID),
+		)
+		return
 	}
 
-	return diag.Diagnostics{}
+	populatePeeringStatus(data.Peering(), &plan.ID, &plan.Status, &plan.StatusMessage)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceVpcPeeringImport(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	var (
-		api  = meta.(*api.API)
-		data map[string]any
-		err  = errors.New("")
-	)
+func (r *vpcPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcPeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Set default values to arguments
-	d.Set("sleep", 60)
-	d.Set("timeout", 3600)
+	// Zero values stored by the SDKv2 implementation are treated as unset.
+	normalizePeeringTarget(&state.InstanceID, &state.VpcID)
 
-	importValues := strings.Split(d.Id(), ",")
-	if len(importValues) < 3 {
-		return diag.Errorf("wrong number of import argument, need all three <type>,<id>,<peering_id>")
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(state.Timeout.ValueInt64())*time.Second)
+	defer cancel()
+
+	peeringID := state.PeeringID.ValueString()
+	data, err := r.client.ReadVpcPeeringRequest(timeoutCtx, state.InstanceID.ValueInt64(),
+		state.VpcID.ValueString(), peeringID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read VPC peering",
+			fmt.Sprintf("Could not read VPC peering %s: %s", peeringID, err),
+		)
+		return
 	}
-	peeringID := importValues[2]
-	d.Set("peering_id", peeringID)
-	if importValues[0] == "instance" {
-		instanceID, _ := strconv.Atoi(importValues[1])
-		d.Set("instance_id", instanceID)
-		data, err = api.ReadVpcPeeringRequest(ctx, instanceID, peeringID)
-	} else if importValues[0] == "vpc" {
-		vpcID := importValues[1]
-		d.Set("vpc_id", vpcID)
-		data, err = api.ReadVpcPeeringRequestWithVpcId(ctx, vpcID, peeringID)
+
+	// Resource drift: peering not found, trigger re-creation
+	if data == nil {
+		tflog.Info(ctx, fmt.Sprintf("VPC peering not found, resource will be recreated: %s", peeringID))
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	for k, v := range data {
-		switch k {
-		case "vpc_peering_connection_id":
-			d.SetId(v.(string))
-		case "status":
-			status := v.(map[string]any)
-			if err = d.Set(k, status["code"]); err != nil {
-				return diag.Errorf("error setting status for resource %s: %s", d.Id(), err)
-			}
-		}
+	populatePeeringStatus(data.Peering(), &state.ID, &state.Status, &state.StatusMessage)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vpcPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcPeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return diag.Diagnostics{}
+	// Only sleep and timeout can be updated, keep the peering status from the state.
+	plan.Status = state.Status
+	plan.StatusMessage = state.StatusMessage
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceVpcPeeringDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func (r *vpcPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcPeeringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var (
-		api        = meta.(*api.API)
-		instanceID = d.Get("instance_id").(int)
-		vpcID      = d.Get("vpc_id").(string)
-		peeringID  = d.Get("peering_id").(string)
-		sleep      = d.Get("sleep").(int)
-		timeout    = d.Get("timeout").(int)
+		peeringID = state.PeeringID.ValueString()
+		sleep     = int(state.Sleep.ValueInt64())
+		timeout   = int(state.Timeout.ValueInt64())
 	)
 
-	if instanceID == 0 && vpcID == "" {
-		return diag.Errorf("you need to specify either instance_id or vpc_id")
-	} else if instanceID != 0 {
-		if err := api.RemoveVpcPeering(ctx, instanceID, peeringID, sleep, timeout); err != nil {
-			return diag.FromErr(err)
-		}
-	} else if vpcID != "" {
-		if err := api.RemoveVpcPeeringWithVpcId(ctx, vpcID, peeringID, sleep, timeout); err != nil {
-			return diag.FromErr(err)
+	err := r.client.RemoveVpcPeering(ctx, state.InstanceID.ValueInt64(), state.VpcID.ValueString(),
+		peeringID, sleep, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove VPC peering",
+			fmt.Sprintf("Could not remove VPC peering %s: %s", peeringID, err),
+		)
+	}
+}
+
+// parseVpcPeeringImportID: parses the import identifier `<vpc_id>,<peering_id>`, or the previous
+// format `<instance|vpc>,<id>,<peering_id>`.
+func parseVpcPeeringImportID(id string) (int64, string, string, error) {
+	parts := strings.Split(id, ",")
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return 0, parts[0], parts[1], nil
+	case len(parts) == 3 && parts[0] == "vpc" && parts[1] != "" && parts[2] != "":
+		return 0, parts[1], parts[2], nil
+	case len(parts) == 3 && parts[0] == "instance" && parts[2] != "":
+		instanceID, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return 0, "", "", fmt.Errorf("could not convert instance_id to int: %s", err)
 		}
+		return instanceID, "", parts[2], nil
+	}
+	return 0, "", "", fmt.Errorf("expected format: {vpc_id},{peering_id}, got: %s", id)
+}
+
+// normalizePeeringTarget: sets zero value instance_id or vpc_id to null.
+func normalizePeeringTarget(instanceID *types.Int64, vpcID *types.String) {
+	if instanceID.ValueInt64() == 0 {
+		*instanceID = types.Int64Null()
+	}
+	if vpcID.ValueString() == "" {
+		*vpcID = types.StringNull()
 	}
+}
 
-	return diag.Diagnostics{}
+// populatePeeringStatus: sets the common status attributes from the provider independent peering.
+func populatePeeringStatus(peering model.Peering, id, status, statusMessage *types.String) {
+	*id = types.StringValue(peering.ID)
+	*status = types.StringValue(peering.Status)
+	*statusMessage = types.StringValue(peering.StatusMessage)
 }
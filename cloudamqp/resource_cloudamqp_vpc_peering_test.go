@@ -32,11 +32,12 @@ func TestAccVpcPeering_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(vpcPeeringResourceName, "vpc_id", params["VpcID"]),
 					resource.TestCheckResourceAttr(vpcPeeringResourceName, "peering_id", params["PeeringID"]),
 					resource.TestCheckResourceAttr(vpcPeeringResourceName, "status", "active"),
+					resource.TestCheckResourceAttr(vpcPeeringResourceName, "status_message", "Active"),
 				),
 			},
 			{
 				ResourceName:            vpcPeeringResourceName,
-				ImportStateId:           fmt.Sprintf("%s,%s,%s", "vpc", params["VpcID"], params["PeeringID"]),
+				ImportStateId:           fmt.Sprintf("%s,%s", params["VpcID"], params["PeeringID"]),
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{},
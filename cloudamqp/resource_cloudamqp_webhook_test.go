@@ -1,9 +1,13 @@
 package cloudamqp
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/cloudamqp/terraform-provider-cloudamqp/api/fakeapi"
 	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/configuration"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -68,3 +72,65 @@ func TestAccWebhook_Basic(t *testing.T) {
 		},
 	})
 }
+
+// TestAccWebhook_DeliveryStatus: Webhook with the status of the last delivery read from the API.
+func TestAccWebhook_DeliveryStatus(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.NewServer(fakeapi.Options{})
+	defer server.Close()
+	instanceID := fakeAPIInstance(t, server)
+	webhookResourceName := "cloudamqp_webhook.webhook_queue"
+
+	config := func(concurrency int) string {
+		return fmt.Sprintf(`
+			resource "cloudamqp_webhook" "webhook_queue" {
+				instance_id = %d
+				vhost       = "vhost"
+				queue       = "webhook-queue"
+				webhook_uri = "https://example.com/webhook"
+				concurrency = %d
+			}`, instanceID, concurrency)
+	}
+
+	cloudamqpFakeAPIResourceTest(t, server, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(webhookResourceName, "concurrency", "1"),
+					resource.TestCheckNoResourceAttr(webhookResourceName, "delivery_status"),
+				),
+			},
+			{
+				PreConfig: func() {
+					// A delivery has been made to the endpoint when the webhook is refreshed.
+					webhooks, err := server.API().ListWebhooks(context.Background(), instanceID, time.Second)
+					if err != nil || len(webhooks) != 1 {
+						t.Fatalf("expected one webhook, webhooks=%+v err=%v", webhooks, err)
+					}
+					server.InjectFault(fakeapi.Fault{
+						Method:     http.MethodGet,
+						Path:       `/webhooks/\d+$`,
+						StatusCode: http.StatusOK,
+						Body: map[string]any{"id": webhooks[0].ID, "concurrency": 1,
+							"webhook_uri": "https://example.com/webhook", "vhost": "vhost",
+							"queue": "webhook-queue", "last_status": "200 OK"},
+						Times: 1,
+					})
+				},
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(webhookResourceName, "delivery_status", "200 OK"),
+				),
+			},
+			{
+				Config: config(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(webhookResourceName, "concurrency", "2"),
+				),
+			},
+		},
+	})
+}
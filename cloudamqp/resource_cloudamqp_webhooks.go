@@ -34,14 +34,15 @@ func NewWebhookResource() resource.Resource {
 }
 
 type webhookResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	InstanceID  types.Int64  `tfsdk:"instance_id"`
-	Vhost       types.String `tfsdk:"vhost"`
-	Queue       types.String `tfsdk:"queue"`
-	WebhookURI  types.String `tfsdk:"webhook_uri"`
-	Concurrency types.Int64  `tfsdk:"concurrency"`
-	Sleep       types.Int64  `tfsdk:"sleep"`
-	Timeout     types.Int64  `tfsdk:"timeout"`
+	ID             types.String `tfsdk:"id"`
+	InstanceID     types.Int64  `tfsdk:"instance_id"`
+	Vhost          types.String `tfsdk:"vhost"`
+	Queue          types.String `tfsdk:"queue"`
+	WebhookURI     types.String `tfsdk:"webhook_uri"`
+	Concurrency    types.Int64  `tfsdk:"concurrency"`
+	Sleep          types.Int64  `tfsdk:"sleep"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	DeliveryStatus types.String `tfsdk:"delivery_status"`
 }
 
 func (r *webhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -109,6 +110,13 @@ func (r *webhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"delivery_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the last delivery made to the endpoint",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -180,6 +188,7 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	plan.ID = types.StringValue(id)
+	plan.DeliveryStatus = types.StringNull()
 	plan.Sleep = types.Int64Value(int64(sleep.Seconds()))
 	plan.Timeout = types.Int64Value(int64(timeout.Seconds()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -222,6 +231,7 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.Queue = types.StringValue(data.Queue)
 	state.Vhost = types.StringValue(data.Vhost)
 	state.WebhookURI = types.StringValue(data.WebhookURI)
+	state.DeliveryStatus = types.StringPointerValue(data.LastStatus)
 	state.Sleep = types.Int64Value(int64(sleep.Seconds()))
 	state.Timeout = types.Int64Value(int64(timeout.Seconds()))
 
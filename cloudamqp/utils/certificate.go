@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// ParsePEMCertificates parses all PEM encoded certificates in data. Fails on blocks that are not
+// certificates, certificates that can't be parsed and data without any certificate.
+func ParsePEMCertificates(data string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+	rest := []byte(strings.TrimSpace(data))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("malformed PEM data after %d certificate(s)", len(certificates))
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block %q, expected CERTIFICATE", block.Type)
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %d: %w", len(certificates)+1, err)
+		}
+		certificates = append(certificates, certificate)
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certificates, nil
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of the certificate, as colon separated
+// upper case hex, e.g. 5E:2B:...
+func CertificateFingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+	encoded := strings.ToUpper(hex.EncodeToString(sum[:]))
+	parts := make([]string, 0, len(sum))
+	for i := 0; i < len(encoded); i += 2 {
+		parts = append(parts, encoded[i:i+2])
+	}
+	return strings.Join(parts, ":")
+}
+
+// ParsePEMPrivateKey parses the first PEM encoded private key in data, in PKCS #1, PKCS #8 or SEC 1
+// (EC) format. EC PARAMETERS blocks before the key are skipped.
+func ParsePEMPrivateKey(data string) (crypto.Signer, error) {
+	rest := []byte(strings.TrimSpace(data))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM encoded private key found")
+		}
+
+		var (
+			key any
+			err error
+		)
+		switch block.Type {
+		case "EC PARAMETERS":
+			continue
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		default:
+			return nil, fmt.Errorf("unexpected PEM block %q, expected a private key", block.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+}
+
+// PrivateKeyMatches reports whether the private key belongs to the public key of the certificate.
+func PrivateKeyMatches(certificate *x509.Certificate, key crypto.Signer) bool {
+	publicKey, ok := certificate.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && publicKey.Equal(key.Public())
+}
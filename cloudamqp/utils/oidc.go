@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxOIDCResponseSize: discovery documents and key sets larger than this are rejected.
+const maxOIDCResponseSize = 1 << 20
+
+// OIDCDiscovery: the fields of the OpenID Connect discovery document used to validate an issuer.
+type OIDCDiscovery struct {
+	Issuer  string `json:"issuer"`
+	JwksURI string `json:"jwks_uri"`
+}
+
+// JSONWebKeySet: the fields of a JSON Web Key Set used to validate an issuer.
+type JSONWebKeySet struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+	} `json:"keys"`
+}
+
+// ValidateOIDCIssuer fetches the OpenID Connect discovery document of the issuer and the JSON Web
+// Key Set it references. Fails when either can't be fetched, the document belongs to another
+// issuer or the key set has no signing keys. Trailing slashes of the issuer are ignored.
+func ValidateOIDCIssuer(ctx context.Context, client *http.Client, issuer string) error {
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	var discovery OIDCDiscovery
+	if err := getJSON(ctx, client, issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return fmt.Errorf("discovery document is for issuer %q", discovery.Issuer)
+	}
+	if discovery.JwksURI == "" {
+		return fmt.Errorf("discovery document has no jwks_uri")
+	}
+
+	var keySet JSONWebKeySet
+	if err := getJSON(ctx, client, discovery.JwksURI, &keySet); err != nil {
+		return fmt.Errorf("failed to fetch JSON Web Key Set: %w", err)
+	}
+	for _, key := range keySet.Keys {
+		if key.Kty != "" && (key.Use == "" || key.Use == "sig") {
+			return nil
+		}
+	}
+	return fmt.Errorf("JSON Web Key Set %s has no signing keys", discovery.JwksURI)
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOIDCResponseSize)).Decode(v); err != nil {
+		return fmt.Errorf("GET %s returned invalid JSON: %w", url, err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateOIDCIssuer(t *testing.T) {
+	var (
+		discovery string
+		jwks      string
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/realms/test/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, discovery)
+	})
+	mux.HandleFunc("/realms/test/certs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, jwks)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var (
+		issuer      = server.URL + "/realms/test"
+		validJWKS   = `{"keys": [{"kty": "RSA", "kid": "enc", "use": "enc"}, {"kty": "RSA", "kid": "sig"}]}`
+		validConfig = fmt.Sprintf(`{"issuer": %q, "jwks_uri": %q}`, issuer, issuer+"/certs")
+	)
+
+	tests := []struct {
+		issuer    string
+		discovery string
+		jwks      string
+		expected  string
+	}{
+		{issuer, validConfig, validJWKS, ""},
+		{issuer + "/", validConfig, validJWKS, ""},
+		{server.URL + "/realms/other", validConfig, validJWKS, "failed to fetch discovery document: GET"},
+		{issuer, "not json", validJWKS, "returned invalid JSON"},
+		{issuer, `{"issuer": "https://other.example.com", "jwks_uri": "x"}`, validJWKS,
+			`discovery document is for issuer "https://other.example.com"`},
+		{issuer, fmt.Sprintf(`{"issuer": %q}`, issuer), validJWKS, "discovery document has no jwks_uri"},
+		{issuer, fmt.Sprintf(`{"issuer": %q, "jwks_uri": %q}`, issuer, issuer+"/missing"), validJWKS,
+			"failed to fetch JSON Web Key Set"},
+		{issuer, validConfig, `{"keys": []}`, "has no signing keys"},
+		{issuer, validConfig, `{"keys": [{"kty": "RSA", "use": "enc"}]}`, "has no signing keys"},
+	}
+	for _, tc := range tests {
+		discovery, jwks = tc.discovery, tc.jwks
+		err := ValidateOIDCIssuer(context.Background(), server.Client(), tc.issuer)
+		if tc.expected == "" && err != nil {
+			t.Errorf("ValidateOIDCIssuer(%q) = %v", tc.issuer, err)
+		}
+		if tc.expected != "" && (err == nil || !strings.Contains(err.Error(), tc.expected)) {
+			t.Errorf("ValidateOIDCIssuer(%q) = %v, expected %q", tc.issuer, err, tc.expected)
+		}
+	}
+}
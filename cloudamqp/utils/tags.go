@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tag is a single key and value pair from a comma separated tags string. Sep is kept to write the
+// tag back in the same format, `key:value` (Datadog) or `key=value`.
+type Tag struct {
+	Key   string
+	Value string
+	Sep   string
+}
+
+func (t Tag) String() string {
+	return t.Key + t.Sep + t.Value
+}
+
+// Tags is an ordered list of unique tags.
+type Tags []Tag
+
+func (t Tags) String() string {
+	tags := make([]string, len(t))
+	for i, tag := range t {
+		tags[i] = tag.String()
+	}
+	return strings.Join(tags, ",")
+}
+
+// ParseTags parses comma separated tags, e.g. `env:prod,service:web`. Both `:` and `=` are accepted
+// as separator between key and value. Whitespace around tags is trimmed and empty entries skipped.
+// Tags repeated with the same key and value are removed, the number of duplicates is returned
+// together with the tags.
+func ParseTags(value string) (Tags, int, error) {
+	var (
+		tags       Tags
+		duplicates int
+		seen       = make(map[string]bool)
+	)
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		i := strings.IndexAny(entry, ":=")
+		if i < 0 {
+			return nil, 0, fmt.Errorf("tag %q must be in key:value format", entry)
+		}
+		tag := Tag{
+			Key:   strings.TrimSpace(entry[:i]),
+			Value: strings.TrimSpace(entry[i+1:]),
+			Sep:   entry[i : i+1],
+		}
+		if tag.Key == "" {
+			return nil, 0, fmt.Errorf("tag %q is missing a key", entry)
+		}
+		if tag.Value == "" {
+			return nil, 0, fmt.Errorf("tag %q is missing a value", entry)
+		}
+		if strings.ContainsAny(tag.Key, " \t") {
+			return nil, 0, fmt.Errorf("tag %q key can not contain whitespace", entry)
+		}
+
+		if seen[tag.Key+"\x00"+tag.Value] {
+			duplicates++
+			continue
+		}
+		seen[tag.Key+"\x00"+tag.Value] = true
+		tags = append(tags, tag)
+	}
+	return tags, duplicates, nil
+}
+
+// NormalizeTags returns the tags with whitespace trimmed and duplicates removed. Tags that can't
+// be parsed are returned unchanged.
+func NormalizeTags(value string) string {
+	tags, _, err := ParseTags(value)
+	if err != nil {
+		return value
+	}
+	return tags.String()
+}
+
+// TagsEqual reports whether two tags strings are equal once normalized.
+func TagsEqual(a, b string) bool {
+	return NormalizeTags(a) == NormalizeTags(b)
+}
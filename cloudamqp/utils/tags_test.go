@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		want       string
+		duplicates int
+		err        string
+	}{
+		{name: "empty", value: "", want: ""},
+		{name: "datadog format", value: "env:prod,service:web", want: "env:prod,service:web"},
+		{name: "legacy format", value: "Project=A,Environment=Development", want: "Project=A,Environment=Development"},
+		{name: "whitespace and empty entries", value: " env:prod , service:web,", want: "env:prod,service:web"},
+		{name: "value with separator", value: "url:https://example.com", want: "url:https://example.com"},
+		{name: "duplicates", value: "env:prod,env:prod,env:test", want: "env:prod,env:test", duplicates: 1},
+		{name: "missing separator", value: "env:prod,web", err: `tag "web" must be in key:value format`},
+		{name: "missing key", value: ":web", err: `tag ":web" is missing a key`},
+		{name: "missing value", value: "env=", err: `tag "env=" is missing a value`},
+		{name: "whitespace in key", value: "my env:prod", err: `tag "my env:prod" key can not contain whitespace`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, duplicates, err := ParseTags(tt.value)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got: %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := tags.String(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+			if duplicates != tt.duplicates {
+				t.Errorf("expected %d duplicates, got %d", tt.duplicates, duplicates)
+			}
+		})
+	}
+}
+
+func TestTagsEqual(t *testing.T) {
+	if !TagsEqual("env:prod, env:prod", "env:prod") {
+		t.Error("expected tags with duplicates to be equal")
+	}
+	if TagsEqual("env:prod", "env=prod") {
+		t.Error("expected tags with different separators to differ")
+	}
+}
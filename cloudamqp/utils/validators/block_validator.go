@@ -0,0 +1,61 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ExactlyOneBlock validates that exactly one of the list or set blocks is configured. Blocks left
+// out of the configuration are empty rather than null, which resourcevalidator.ExactlyOneOf counts
+// as configured.
+type ExactlyOneBlock struct {
+	Blocks []string
+}
+
+func (v ExactlyOneBlock) Description(ctx context.Context) string {
+	return fmt.Sprintf("Exactly one of these blocks must be configured: [%s]", strings.Join(v.Blocks, ", "))
+}
+
+func (v ExactlyOneBlock) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ExactlyOneBlock) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config map[string]tftypes.Value
+	if err := req.Config.Raw.As(&config); err != nil {
+		resp.Diagnostics.AddError("Failed to read configuration", err.Error())
+		return
+	}
+
+	var configured []string
+	for _, name := range v.Blocks {
+		value, ok := config[name]
+		if !ok || value.IsNull() {
+			continue
+		}
+		// Blocks generated by dynamic blocks can be unknown, validated once known.
+		if !value.IsKnown() {
+			return
+		}
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			resp.Diagnostics.AddError("Failed to read configuration", err.Error())
+			return
+		}
+		if len(elements) > 0 {
+			configured = append(configured, name)
+		}
+	}
+
+	if len(configured) != 1 {
+		detail := v.Description(ctx)
+		if len(configured) > 1 {
+			detail += fmt.Sprintf(", got: [%s]", strings.Join(configured, ", "))
+		}
+		resp.Diagnostics.AddError("Invalid Block Combination", detail)
+	}
+}
@@ -0,0 +1,70 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DatadogSites are the Datadog sites accepted as region, see https://docs.datadoghq.com/getting_started/site/
+var DatadogSites = []string{"us1", "us3", "us5", "eu1", "ap1", "ap2", "gov"}
+
+// datadogLegacyRegions are regions accepted before sites were validated, mapped to their site.
+var datadogLegacyRegions = map[string]string{
+	"us": "us1",
+	"eu": "eu1",
+}
+
+// DatadogSiteValidator validates the region of a Datadog integration. AllowLegacy accepts the
+// legacy regions with a warning, for integrations that accepted them before.
+type DatadogSiteValidator struct {
+	AllowLegacy bool
+}
+
+func (v DatadogSiteValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Must be a Datadog site: %s", strings.Join(DatadogSites, ", "))
+}
+
+func (v DatadogSiteValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v DatadogSiteValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	resp.Diagnostics.Append(ValidateDatadogSite(req.Path, req.ConfigValue, v.AllowLegacy)...)
+}
+
+// ValidateDatadogSite validates the region, for resources where the region is only a Datadog site
+// for some integration types.
+func ValidateDatadogSite(p path.Path, value types.String, allowLegacy bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	region := strings.ToLower(value.ValueString())
+	if slices.Contains(DatadogSites, region) {
+		return diags
+	}
+	if site, ok := datadogLegacyRegions[region]; ok && allowLegacy {
+		diags.AddAttributeWarning(
+			p,
+			"Legacy Datadog Region",
+			fmt.Sprintf("Region %q is deprecated, use the Datadog site %q instead.", value.ValueString(), site),
+		)
+		return diags
+	}
+	diags.AddAttributeError(
+		p,
+		"Invalid Datadog Site",
+		fmt.Sprintf("Region %q is not a Datadog site, valid sites: %s. The site is found in the URL "+
+			"used to log in to Datadog, e.g. us5.datadoghq.com for us5.",
+			value.ValueString(), strings.Join(DatadogSites, ", ")),
+	)
+	return diags
+}
@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/utils"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// TagsValidator validates comma separated tags in key:value format, duplicated tags are removed
+// before sent to the API.
+type TagsValidator struct{}
+
+func (v TagsValidator) Description(ctx context.Context) string {
+	return "Must be comma separated tags in key:value format, e.g. env:prod,service:web"
+}
+
+func (v TagsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TagsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, duplicates, err := utils.ParseTags(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Tags",
+			fmt.Sprintf("%s. Tags must be comma separated, e.g. env:prod,service:web", err),
+		)
+		return
+	}
+	if duplicates > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Duplicate Tags",
+			fmt.Sprintf("%d duplicated tag(s) will be removed", duplicates),
+		)
+	}
+}
@@ -0,0 +1,220 @@
+// Package cassettelint validates the go-vcr cassettes used by the acceptance tests. It checks for
+// leaked secrets, cassettes without a matching test and interactions never matched during replay.
+package cassettelint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/sanitizer"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// Finding is a single problem found in a cassette.
+type Finding struct {
+	// Cassette name, relative to the cassette directory and without extension.
+	Cassette string
+	// Interaction identifier, -1 when the finding concerns the whole cassette.
+	Interaction int
+	// Check that reported the finding: secret, orphan, matcher or unmatched.
+	Check   string
+	Message string
+}
+
+func (f Finding) String() string {
+	if f.Interaction < 0 {
+		return fmt.Sprintf("%s: [%s] %s", f.Cassette, f.Check, f.Message)
+	}
+	return fmt.Sprintf("%s#%d: [%s] %s", f.Cassette, f.Interaction, f.Check, f.Message)
+}
+
+// Cassettes returns the names of all cassettes in the directory, relative to the directory and
+// without extension, e.g. TestAccVpc_InvalidCIDR/10.0.0.0
+func Cassettes(dir string) ([]string, error) {
+	var names []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+		name, err := filepath.Rel(dir, strings.TrimSuffix(path, ".yaml"))
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(name))
+		return nil
+	})
+	sort.Strings(names)
+	return names, err
+}
+
+// TestNames returns the names of all test functions declared in the Go test files of the directory.
+func TestNames(dir string) ([]string, error) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if ok && fn.Recv == nil && strings.HasPrefix(fn.Name.Name, "Test") {
+				names = append(names, fn.Name.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// CheckOrphans reports cassettes without a test with the same name. Cassettes recorded by subtests
+// are stored in a directory named after the parent test.
+func CheckOrphans(cassettes, testNames []string) []Finding {
+	tests := make(map[string]bool, len(testNames))
+	for _, name := range testNames {
+		tests[name] = true
+	}
+
+	var findings []Finding
+	for _, name := range cassettes {
+		testName, _, _ := strings.Cut(name, "/")
+		if !tests[testName] {
+			findings = append(findings, Finding{
+				Cassette:    name,
+				Interaction: -1,
+				Check:       "orphan",
+				Message:     fmt.Sprintf("no test named %s", testName),
+			})
+		}
+	}
+	return findings
+}
+
+// CheckSecrets re-runs the sanitizer rules applied when recording and reports interactions that
+// would have been changed by them, e.g. unredacted headers, URL credentials and sensitive data.
+func CheckSecrets(name string, c *cassette.Cassette, s *sanitizer.Sanitizer) []Finding {
+	var findings []Finding
+	for _, i := range c.Interactions {
+		for _, rule := range s.Changes(i) {
+			findings = append(findings, Finding{
+				Cassette:    name,
+				Interaction: i.ID,
+				Check:       "secret",
+				Message:     fmt.Sprintf("not sanitized by rule %s", rule),
+			})
+		}
+	}
+	return findings
+}
+
+// CheckMatcher reports interactions that can't be matched by the matcher, e.g. due to an invalid
+// URL, by replaying the recorded request against it.
+func CheckMatcher(name string, c *cassette.Cassette, matcher cassette.MatcherFunc) []Finding {
+	var findings []Finding
+	for _, i := range c.Interactions {
+		request, err := i.GetHTTPRequest()
+		if err == nil && !safeMatch(matcher, request, i.Request) {
+			err = fmt.Errorf("recorded request is not matched by the matcher")
+		}
+		if err != nil {
+			findings = append(findings, Finding{
+				Cassette:    name,
+				Interaction: i.ID,
+				Check:       "matcher",
+				Message:     fmt.Sprintf("%s %s: %s", i.Request.Method, i.Request.URL, err),
+			})
+		}
+	}
+	return findings
+}
+
+// Tracker wraps a matcher and keeps track of the interactions replayed. The cassette replays the
+// first unused interaction matched, so every successful match consumes one interaction.
+type Tracker struct {
+	mu       sync.Mutex
+	matcher  cassette.MatcherFunc
+	replayed map[string]int
+}
+
+// NewTracker returns a tracker for the matcher, use Tracker.Matcher with the recorder.
+func NewTracker(matcher cassette.MatcherFunc) *Tracker {
+	return &Tracker{matcher: matcher, replayed: make(map[string]int)}
+}
+
+// Matcher matches the request and records the interaction as replayed on success.
+func (t *Tracker) Matcher(r *http.Request, i cassette.Request) bool {
+	if !t.matcher(r, i) {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.replayed[requestKey(i)]++
+	return true
+}
+
+// CheckUnmatched reports interactions in the cassette never matched while replaying it.
+func (t *Tracker) CheckUnmatched(name string, c *cassette.Cassette) []Finding {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var (
+		findings []Finding
+		seen     = make(map[string]int)
+	)
+	for _, i := range c.Interactions {
+		key := requestKey(i.Request)
+		seen[key]++
+		if seen[key] > t.replayed[key] {
+			findings = append(findings, Finding{
+				Cassette:    name,
+				Interaction: i.ID,
+				Check:       "unmatched",
+				Message:     fmt.Sprintf("%s was never replayed", key),
+			})
+		}
+	}
+	return findings
+}
+
+// Load reads the cassette from the directory.
+func Load(dir, name string) (*cassette.Cassette, error) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if _, err := os.Stat(path + ".yaml"); err != nil {
+		return nil, err
+	}
+	return cassette.Load(path)
+}
+
+func requestKey(r cassette.Request) string {
+	if u, err := url.Parse(r.URL); err == nil {
+		return fmt.Sprintf("%s %s", r.Method, u.RequestURI())
+	}
+	return fmt.Sprintf("%s %s", r.Method, r.URL)
+}
+
+func safeMatch(matcher cassette.MatcherFunc, r *http.Request, i cassette.Request) (matched bool) {
+	defer func() {
+		if recover() != nil {
+			matched = false
+		}
+	}()
+	return matcher(r, i)
+}
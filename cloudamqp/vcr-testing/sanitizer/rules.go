@@ -0,0 +1,157 @@
+package sanitizer
+
+import (
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// JSONPathRule replaces string values in JSON request and response bodies.
+type JSONPathRule struct {
+	Name string
+	// Paths in gjson syntax, a single # iterates over array elements, e.g. #.config.api_key
+	Paths []string
+	// Match limits the rule to values matching the expression, all values when nil.
+	Match *regexp.Regexp
+	// Replace returns the sanitized value, when nil the value is replaced with the placeholder.
+	Replace     func(value string) string
+	Placeholder string
+}
+
+func (r JSONPathRule) RuleName() string { return r.Name }
+
+func (r JSONPathRule) Apply(i *cassette.Interaction) {
+	i.Request.Body = r.sanitize(i.Request.Body)
+	i.Response.Body = r.sanitize(i.Response.Body)
+}
+
+func (r JSONPathRule) sanitize(body string) string {
+	if !gjson.Valid(body) {
+		return body
+	}
+	for _, path := range r.Paths {
+		for _, p := range expandPath(body, path) {
+			value := gjson.Get(body, p)
+			if value.Type != gjson.String || (r.Match != nil && !r.Match.MatchString(value.String())) {
+				continue
+			}
+			sanitized := placeholder(r.Placeholder)
+			if r.Replace != nil {
+				sanitized = r.Replace(value.String())
+			}
+			if sanitized == value.String() {
+				continue
+			}
+			if updated, err := sjson.Set(body, p, sanitized); err == nil {
+				body = updated
+			}
+		}
+	}
+	return body
+}
+
+// expandPath: Expands # in the path to the array indexes present in the body.
+func expandPath(body, path string) []string {
+	prefix, rest, found := strings.Cut(path, "#.")
+	if !found {
+		return []string{path}
+	}
+	var paths []string
+	count := int(gjson.Get(body, prefix+"#").Int())
+	for index := range count {
+		paths = append(paths, expandPath(body, prefix+strconv.Itoa(index)+"."+rest)...)
+	}
+	return paths
+}
+
+// RegexRule replaces matches of the pattern in request and response bodies. The replacement can
+// refer to submatches, see regexp.Regexp.Expand.
+type RegexRule struct {
+	Name        string
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+func (r RegexRule) RuleName() string { return r.Name }
+
+func (r RegexRule) Apply(i *cassette.Interaction) {
+	i.Request.Body = r.Pattern.ReplaceAllString(i.Request.Body, r.Replacement)
+	i.Response.Body = r.Pattern.ReplaceAllString(i.Response.Body, r.Replacement)
+}
+
+// HeaderRule replaces all values of the header in requests and responses.
+type HeaderRule struct {
+	Name        string
+	Header      string
+	Placeholder string
+}
+
+func (r HeaderRule) RuleName() string { return r.Name }
+
+func (r HeaderRule) Apply(i *cassette.Interaction) {
+	for _, headers := range []http.Header{i.Request.Headers, i.Response.Headers} {
+		key := http.CanonicalHeaderKey(r.Header)
+		values, ok := headers[key]
+		if !ok {
+			continue
+		}
+		sanitized := make([]string, len(values))
+		for index := range values {
+			sanitized[index] = placeholder(r.Placeholder)
+		}
+		headers[key] = sanitized
+	}
+}
+
+// ValueRule replaces all occurrences of the value in request and response bodies.
+type ValueRule struct {
+	Name        string
+	Value       string
+	Placeholder string
+}
+
+func (r ValueRule) RuleName() string { return r.Name }
+
+func (r ValueRule) Apply(i *cassette.Interaction) {
+	i.Request.Body = FilterSensitiveData(i.Request.Body, r.Value, placeholder(r.Placeholder))
+	i.Response.Body = FilterSensitiveData(i.Response.Body, r.Value, placeholder(r.Placeholder))
+}
+
+// RuleSet groups rules applied in order under a single name.
+type RuleSet struct {
+	Name  string
+	Rules []Rule
+}
+
+func (r RuleSet) RuleName() string { return r.Name }
+
+func (r RuleSet) Apply(i *cassette.Interaction) {
+	for _, rule := range r.Rules {
+		rule.Apply(i)
+	}
+}
+
+// EnvValues returns a rule replacing the values of the environment variables with their names,
+// e.g. the value of DATADOG_APIKEY with DATADOG_APIKEY. Unset variables are ignored.
+func EnvValues(names ...string) RuleSet {
+	rules := RuleSet{Name: "env-values"}
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			rules.Rules = append(rules.Rules, ValueRule{Name: name, Value: value, Placeholder: name})
+		}
+	}
+	return rules
+}
+
+func placeholder(value string) string {
+	if value == "" {
+		return Placeholder
+	}
+	return value
+}
@@ -1,14 +1,99 @@
+// Package sanitizer removes secrets from the go-vcr interactions before the cassettes are saved.
+// Rules are applied to request and response bodies and headers, the default rule set can be
+// extended or reduced per test.
 package sanitizer
 
 import (
-	"fmt"
-	"net/url"
+	"maps"
+	"net/http"
+	"slices"
 	"strings"
 
-	"github.com/tidwall/gjson"
-	"github.com/tidwall/sjson"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
+// Placeholder replacing sanitized values, unless the rule specifies its own.
+const Placeholder = "REDACTED"
+
+// Rule sanitizes a recorded interaction in place.
+type Rule interface {
+	// RuleName identifies the rule, used to remove it from a sanitizer and in lint findings.
+	RuleName() string
+	Apply(i *cassette.Interaction)
+}
+
+// Sanitizer applies a set of rules to the recorded interactions.
+type Sanitizer struct {
+	rules []Rule
+}
+
+// New returns a sanitizer applying the rules in order.
+func New(rules ...Rule) *Sanitizer {
+	return &Sanitizer{rules: slices.Clone(rules)}
+}
+
+// Default returns a sanitizer with all built-in rules.
+func Default() *Sanitizer {
+	return New(
+		AuthorizationHeader,
+		SetCookieHeader,
+		URLCredentials,
+		PEMPrivateKey,
+		GCPServiceAccount,
+		DatadogAPIKey,
+		WebhookToken,
+	)
+}
+
+// With returns a copy of the sanitizer with the rules appended.
+func (s *Sanitizer) With(rules ...Rule) *Sanitizer {
+	return New(append(slices.Clone(s.rules), rules...)...)
+}
+
+// Without returns a copy of the sanitizer without the rules with the names.
+func (s *Sanitizer) Without(names ...string) *Sanitizer {
+	return New(slices.DeleteFunc(slices.Clone(s.rules), func(rule Rule) bool {
+		return slices.Contains(names, rule.RuleName())
+	})...)
+}
+
+// Rules returns the names of the rules applied, in order.
+func (s *Sanitizer) Rules() []string {
+	names := make([]string, len(s.rules))
+	for i, rule := range s.rules {
+		names[i] = rule.RuleName()
+	}
+	return names
+}
+
+// Apply all rules to the interaction.
+func (s *Sanitizer) Apply(i *cassette.Interaction) {
+	for _, rule := range s.rules {
+		rule.Apply(i)
+	}
+}
+
+// Hook applies all rules to the interaction, to be used as recorder.BeforeSaveHook.
+func (s *Sanitizer) Hook(i *cassette.Interaction) error {
+	s.Apply(i)
+	return nil
+}
+
+// Changes returns the names of the rules that would change the interaction, without changing it.
+// An interaction saved by a recorder using the same rules results in no changes.
+func (s *Sanitizer) Changes(i *cassette.Interaction) []string {
+	var names []string
+	for _, rule := range s.rules {
+		sanitized := clone(i)
+		rule.Apply(sanitized)
+		if !equal(i, sanitized) {
+			names = append(names, rule.RuleName())
+		}
+	}
+	return names
+}
+
+// FilterSensitiveData replaces all occurrences of the value with the placeholder.
 func FilterSensitiveData(jsonBody, value, placeholder string) string {
 	if len(value) == 0 {
 		return jsonBody
@@ -16,15 +101,32 @@ func FilterSensitiveData(jsonBody, value, placeholder string) string {
 	return strings.ReplaceAll(jsonBody, value, placeholder)
 }
 
+// URL masks passwords of URLs in the fields url, urls.external and urls.internal.
 func URL(jsonBody string) string {
-	urlFields := []string{"url", "urls.external", "urls.internal"}
-	for _, urlField := range urlFields {
-		field := gjson.Get(jsonBody, urlField)
-		if field.Exists() {
-			u, _ := url.Parse(field.String())
-			sanitizedUrl := fmt.Sprintf("%s://%s:***@%s%s", u.Scheme, u.User.Username(), u.Host, u.Path)
-			jsonBody, _ = sjson.Set(jsonBody, urlField, sanitizedUrl)
-		}
+	return URLCredentials.sanitize(jsonBody)
+}
+
+func clone(i *cassette.Interaction) *cassette.Interaction {
+	c := *i
+	c.Request.Headers = cloneHeader(i.Request.Headers)
+	c.Response.Headers = cloneHeader(i.Response.Headers)
+	return &c
+}
+
+func cloneHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	c := make(http.Header, len(h))
+	for key, values := range h {
+		c[key] = slices.Clone(values)
 	}
-	return jsonBody
+	return c
+}
+
+func equal(a, b *cassette.Interaction) bool {
+	return a.Request.Body == b.Request.Body &&
+		a.Response.Body == b.Response.Body &&
+		maps.EqualFunc(a.Request.Headers, b.Request.Headers, slices.Equal) &&
+		maps.EqualFunc(a.Response.Headers, b.Response.Headers, slices.Equal)
 }
@@ -0,0 +1,35 @@
+package cloudamqp
+
+import (
+	"testing"
+
+	"github.com/cloudamqp/terraform-provider-cloudamqp/cloudamqp/vcr-testing/cassettelint"
+)
+
+// TestVcrCassettes: Lint all cassettes for leaked secrets, orphaned cassettes and interactions
+// that can't be matched during replay.
+func TestVcrCassettes(t *testing.T) {
+	names, err := cassettelint.Cassettes(cassetteDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testNames, err := cassettelint.TestNames(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	findings := cassettelint.CheckOrphans(names, testNames)
+	for _, name := range names {
+		c, err := cassettelint.Load(cassetteDir, name)
+		if err != nil {
+			t.Errorf("%s: %s", name, err)
+			continue
+		}
+		findings = append(findings, cassettelint.CheckSecrets(name, c, cassetteSanitizer(name))...)
+		findings = append(findings, cassettelint.CheckMatcher(name, c, requestURIMatcher)...)
+	}
+
+	for _, finding := range findings {
+		t.Error(finding)
+	}
+}